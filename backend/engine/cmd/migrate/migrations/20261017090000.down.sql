@@ -0,0 +1,12 @@
+DROP INDEX idx_matches_group_id;
+DROP INDEX idx_groups_round_id;
+
+ALTER TABLE player_groups
+    DROP CONSTRAINT player_groups_pkey;
+
+ALTER TABLE matches
+    DROP COLUMN position;
+ALTER TABLE groups
+    DROP COLUMN position;
+ALTER TABLE rounds
+    DROP COLUMN position;
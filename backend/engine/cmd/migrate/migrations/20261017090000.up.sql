@@ -0,0 +1,12 @@
+ALTER TABLE rounds
+    ADD COLUMN position INT NOT NULL DEFAULT 0;
+ALTER TABLE groups
+    ADD COLUMN position INT NOT NULL DEFAULT 0;
+ALTER TABLE matches
+    ADD COLUMN position INT NOT NULL DEFAULT 0;
+
+ALTER TABLE player_groups
+    ADD PRIMARY KEY (player_id, group_id);
+
+CREATE INDEX idx_groups_round_id ON groups (round_id);
+CREATE INDEX idx_matches_group_id ON matches (group_id);
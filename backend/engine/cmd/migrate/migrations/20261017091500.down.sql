@@ -0,0 +1,3 @@
+ALTER TABLE tournaments
+    DROP COLUMN seeding_seed,
+    DROP COLUMN seeding_strategy;
@@ -0,0 +1,3 @@
+ALTER TABLE tournaments
+    ADD COLUMN seeding_strategy VARCHAR(50) NOT NULL DEFAULT 'SNAKE',
+    ADD COLUMN seeding_seed     BIGINT      NOT NULL DEFAULT 0;
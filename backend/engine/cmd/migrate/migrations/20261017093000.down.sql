@@ -0,0 +1,4 @@
+ALTER TABLE rounds
+    DROP COLUMN points_scheme;
+ALTER TABLE tournaments
+    DROP COLUMN points_scheme;
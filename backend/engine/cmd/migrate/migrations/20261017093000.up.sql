@@ -0,0 +1,4 @@
+ALTER TABLE tournaments
+    ADD COLUMN points_scheme JSONB NOT NULL DEFAULT '{"type": "LINEAR"}';
+ALTER TABLE rounds
+    ADD COLUMN points_scheme JSONB DEFAULT NULL;
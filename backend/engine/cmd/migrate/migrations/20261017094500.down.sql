@@ -0,0 +1,3 @@
+ALTER TABLE rounds
+    DROP COLUMN tie_break_seed,
+    DROP COLUMN tie_breakers;
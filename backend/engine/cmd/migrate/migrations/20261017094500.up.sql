@@ -0,0 +1,3 @@
+ALTER TABLE rounds
+    ADD COLUMN tie_breakers   JSONB  DEFAULT NULL,
+    ADD COLUMN tie_break_seed BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1,8 @@
+UPDATE tournaments
+SET status = 'ACTIVE'
+WHERE status = 'PAUSED';
+
+ALTER TABLE tournaments
+    DROP COLUMN paused_at,
+    DROP COLUMN pause_reason,
+    DROP COLUMN paused_by;
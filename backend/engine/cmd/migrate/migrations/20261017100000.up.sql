@@ -0,0 +1,4 @@
+ALTER TABLE tournaments
+    ADD COLUMN paused_by    VARCHAR(255) DEFAULT NULL,
+    ADD COLUMN pause_reason TEXT         DEFAULT NULL,
+    ADD COLUMN paused_at    TIMESTAMP    DEFAULT NULL;
@@ -0,0 +1 @@
+DROP TABLE qualifying_attempts;
@@ -0,0 +1,10 @@
+CREATE TABLE qualifying_attempts
+(
+    id            UUID PRIMARY KEY   DEFAULT gen_random_uuid(),
+    tournament_id UUID REFERENCES tournaments (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    player_id     UUID REFERENCES players (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    time          INT       NOT NULL,
+    created_at    TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX qualifying_attempts_tournament_player_idx ON qualifying_attempts (tournament_id, player_id);
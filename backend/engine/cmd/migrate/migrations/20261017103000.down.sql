@@ -0,0 +1,3 @@
+ALTER TABLE tournaments
+    DROP COLUMN qualifying_mode,
+    DROP COLUMN qualifying_attempt_limit;
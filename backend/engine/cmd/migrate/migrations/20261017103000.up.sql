@@ -0,0 +1,3 @@
+ALTER TABLE tournaments
+    ADD COLUMN qualifying_attempt_limit INT         NOT NULL DEFAULT 0,
+    ADD COLUMN qualifying_mode          VARCHAR(20) NOT NULL DEFAULT 'BEST';
@@ -0,0 +1,5 @@
+ALTER TABLE tournaments
+    DROP COLUMN qualifying_closed_at;
+
+ALTER TABLE players
+    DROP COLUMN status;
@@ -0,0 +1,5 @@
+ALTER TABLE players
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'REGISTERED';
+
+ALTER TABLE tournaments
+    ADD COLUMN qualifying_closed_at TIMESTAMP DEFAULT NULL;
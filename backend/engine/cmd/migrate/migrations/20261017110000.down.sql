@@ -0,0 +1,4 @@
+ALTER TABLE tournaments
+    DROP COLUMN qualifying_opened_at,
+    DROP COLUMN qualifying_closes_at,
+    DROP COLUMN qualifying_opens_at;
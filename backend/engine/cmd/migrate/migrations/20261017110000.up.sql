@@ -0,0 +1,4 @@
+ALTER TABLE tournaments
+    ADD COLUMN qualifying_opens_at  TIMESTAMP DEFAULT NULL,
+    ADD COLUMN qualifying_closes_at TIMESTAMP DEFAULT NULL,
+    ADD COLUMN qualifying_opened_at TIMESTAMP DEFAULT NULL;
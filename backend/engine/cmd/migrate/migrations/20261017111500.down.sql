@@ -0,0 +1,10 @@
+DROP TABLE match_players;
+
+ALTER TABLE matches
+    DROP COLUMN stage;
+
+ALTER TABLE player_groups
+    DROP COLUMN seed;
+
+ALTER TABLE rounds
+    DROP COLUMN type;
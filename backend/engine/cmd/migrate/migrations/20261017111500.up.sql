@@ -0,0 +1,16 @@
+ALTER TABLE rounds
+    ADD COLUMN type VARCHAR(30) NOT NULL DEFAULT 'GROUP';
+
+ALTER TABLE player_groups
+    ADD COLUMN seed INT NOT NULL DEFAULT 0;
+
+ALTER TABLE matches
+    ADD COLUMN stage INT NOT NULL DEFAULT 0;
+
+CREATE TABLE match_players
+(
+    match_id  UUID REFERENCES matches (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    player_id UUID REFERENCES players (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    seed      INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (match_id, player_id)
+);
@@ -0,0 +1,7 @@
+ALTER TABLE matches
+    DROP COLUMN loser_next_match_position,
+    DROP COLUMN next_match_position,
+    DROP COLUMN bracket;
+
+ALTER TABLE rounds
+    DROP COLUMN grand_final_reset;
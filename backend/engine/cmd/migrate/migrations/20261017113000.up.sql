@@ -0,0 +1,7 @@
+ALTER TABLE rounds
+    ADD COLUMN grand_final_reset BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE matches
+    ADD COLUMN bracket                   VARCHAR(20) DEFAULT NULL,
+    ADD COLUMN next_match_position       INT         DEFAULT NULL,
+    ADD COLUMN loser_next_match_position INT         DEFAULT NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE rounds
+    DROP COLUMN round_robin_cycles;
@@ -0,0 +1,2 @@
+ALTER TABLE rounds
+    ADD COLUMN round_robin_cycles INT NOT NULL DEFAULT 1;
@@ -0,0 +1,2 @@
+ALTER TABLE rounds
+    ADD COLUMN lucky_loser_count INT NOT NULL DEFAULT 0;
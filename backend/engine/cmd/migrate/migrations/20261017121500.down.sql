@@ -0,0 +1,8 @@
+ALTER TABLE matches
+    DROP COLUMN map_picker_id;
+
+ALTER TABLE rounds
+    DROP COLUMN map_pool;
+
+ALTER TABLE tournaments
+    DROP COLUMN map_pool;
@@ -0,0 +1,8 @@
+ALTER TABLE tournaments
+    ADD COLUMN map_pool JSONB;
+
+ALTER TABLE rounds
+    ADD COLUMN map_pool JSONB;
+
+ALTER TABLE matches
+    ADD COLUMN map_picker_id UUID REFERENCES players (id) ON DELETE SET NULL;
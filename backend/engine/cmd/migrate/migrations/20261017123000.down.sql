@@ -0,0 +1,6 @@
+DROP TABLE schedule_delays;
+
+ALTER TABLE tournaments
+    DROP COLUMN schedule_starts_at,
+    DROP COLUMN match_duration_minutes,
+    DROP COLUMN station_count;
@@ -0,0 +1,17 @@
+ALTER TABLE tournaments
+    ADD COLUMN schedule_starts_at     TIMESTAMP DEFAULT NULL,
+    ADD COLUMN match_duration_minutes INT NOT NULL DEFAULT 0,
+    ADD COLUMN station_count          INT NOT NULL DEFAULT 0;
+
+CREATE TABLE schedule_delays
+(
+    id            UUID PRIMARY KEY   DEFAULT gen_random_uuid(),
+    tournament_id UUID REFERENCES tournaments (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    round_id      UUID REFERENCES rounds (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    slot          INT       NOT NULL,
+    minutes       INT       NOT NULL,
+    reason        VARCHAR(255),
+    created_at    TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX schedule_delays_tournament_idx ON schedule_delays (tournament_id);
@@ -0,0 +1,11 @@
+CREATE TABLE stations
+(
+    id            UUID PRIMARY KEY   DEFAULT gen_random_uuid(),
+    tournament_id UUID REFERENCES tournaments (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    number        INT       NOT NULL,
+    name          VARCHAR(255),
+    group_id      UUID      REFERENCES groups (id) ON DELETE SET NULL ON UPDATE CASCADE,
+    assigned_at   TIMESTAMP DEFAULT NULL,
+    created_at    TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (tournament_id, number)
+);
@@ -0,0 +1,11 @@
+CREATE TABLE outbox_events
+(
+    id            BIGSERIAL PRIMARY KEY,
+    tournament_id UUID         NOT NULL,
+    name          VARCHAR(255) NOT NULL,
+    payload       JSONB        NOT NULL,
+    created_at    TIMESTAMP    NOT NULL DEFAULT NOW(),
+    dispatched_at TIMESTAMP DEFAULT NULL
+);
+
+CREATE INDEX outbox_events_pending_idx ON outbox_events (id) WHERE dispatched_at IS NULL;
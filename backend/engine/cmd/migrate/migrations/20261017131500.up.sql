@@ -0,0 +1,9 @@
+CREATE TABLE broker_messages
+(
+    id           BIGSERIAL PRIMARY KEY,
+    topic        VARCHAR(255) NOT NULL,
+    payload      JSONB        NOT NULL,
+    published_at TIMESTAMP    NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX broker_messages_published_at_idx ON broker_messages (published_at);
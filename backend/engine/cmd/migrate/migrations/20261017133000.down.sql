@@ -0,0 +1,2 @@
+ALTER TABLE groups
+    DROP CONSTRAINT groups_round_id_position_key;
@@ -0,0 +1,10 @@
+-- Groups created before positions existed all have position 0, so rounds with clashing positions are renumbered first
+UPDATE groups g
+SET position = ordered.position
+FROM (SELECT id, ROW_NUMBER() OVER (PARTITION BY round_id ORDER BY position, name, id) - 1 AS position
+      FROM groups) ordered
+WHERE g.id = ordered.id
+  AND g.round_id IN (SELECT round_id FROM groups GROUP BY round_id, position HAVING COUNT(*) > 1);
+
+ALTER TABLE groups
+    ADD CONSTRAINT groups_round_id_position_key UNIQUE (round_id, position);
@@ -0,0 +1,5 @@
+CREATE TABLE worker_watermarks
+(
+    name          VARCHAR(255) PRIMARY KEY,
+    watched_until TIMESTAMP    NOT NULL
+);
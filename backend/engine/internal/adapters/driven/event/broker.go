@@ -1,14 +1,29 @@
 package event
 
 import (
-	"encoding/json"
 	"sync"
+	"time"
 )
 
 // Message represents a pub-sub message with a topic and payload.
+// Seq increases monotonically across all topics, so clients can resume after the last message they received.
+// Epoch identifies the sequence that Seq belongs to; it changes when the sequence starts over, such as after a restart.
 type Message struct {
-	Topic   string `json:"topic"`
-	Payload any    `json:"payload"`
+	Epoch     string    `json:"epoch"`
+	Seq       uint64    `json:"seq"`
+	Topic     string    `json:"topic"`
+	Payload   any       `json:"payload"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// replayRequest subscribes a client to topics and replays the retained messages published after fromSeq.
+// Stored holds messages loaded from persistent storage, which are replayed together with the broker's history.
+type replayRequest struct {
+	client  *Client
+	topics  []string
+	epoch   string
+	fromSeq uint64
+	stored  []Message
 }
 
 // Client represents a WebSocket client with subscriptions and an outgoing message channel.
@@ -26,7 +41,7 @@ func NewClient() *Client {
 	}
 }
 
-// SubscribeTo adds a topic to the client's subscriptions.
+// SubscribeTo adds a topic or a wildcard pattern to the client's subscriptions.
 func (c *Client) SubscribeTo(topic string) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -40,72 +55,41 @@ func (c *Client) UnsubscribeFrom(topic string) {
 	delete(c.Topics, topic)
 }
 
-// IsSubscribed checks if the client is subscribed to a topic.
+// IsSubscribed checks if the client is subscribed to a topic, either directly or through a wildcard pattern.
 func (c *Client) IsSubscribed(topic string) bool {
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	return c.Topics[topic]
-}
-
-// Broker manages client connections and message distribution.
-type Broker struct {
-	Clients    map[*Client]bool
-	NewClients chan *Client
-	Defunct    chan *Client
-	Messages   chan Message
-}
-
-// NewBroker creates a new message broker.
-func NewBroker() *Broker {
-	return &Broker{
-		Clients:    make(map[*Client]bool),
-		NewClients: make(chan *Client),
-		Defunct:    make(chan *Client),
-		Messages:   make(chan Message, 256),
+	if c.Topics[topic] {
+		return true
 	}
+	for pattern := range c.Topics {
+		if MatchTopic(pattern, topic) {
+			return true
+		}
+	}
+	return false
 }
 
-// Start runs the broker's event loop in a goroutine.
-func (b *Broker) Start() {
-	go func() {
-		for {
-			select {
-			case client := <-b.NewClients:
-				b.Clients[client] = true
+// Broker distributes published messages to the clients subscribed to their topics.
+type Broker interface {
+	// Start runs the broker's event loop in a goroutine.
+	Start()
 
-			case client := <-b.Defunct:
-				if b.Clients[client] {
-					delete(b.Clients, client)
-					close(client.Send)
-				}
+	// Publish sends a message to all subscribers of a topic.
+	Publish(topic string, payload any) error
 
-			case message := <-b.Messages:
-				data, err := json.Marshal(message)
-				if err != nil {
-					continue
-				}
+	// Register adds a client that receives the messages of its subscribed topics.
+	Register(client *Client)
 
-				for client := range b.Clients {
-					if client.IsSubscribed(message.Topic) {
-						select {
-						case client.Send <- data:
-							// Message sent successfully
-						default:
-							// Client's send buffer is full, remove and close
-							delete(b.Clients, client)
-							close(client.Send)
-						}
-					}
-				}
-			}
-		}
-	}()
-}
+	// Unregister removes a client and closes its send channel.
+	Unregister(client *Client)
 
-// Publish sends a message to all subscribers of a topic.
-func (b *Broker) Publish(topic string, payload any) {
-	b.Messages <- Message{
-		Topic:   topic,
-		Payload: payload,
-	}
+	// SubscribeFrom subscribes a client to topics and first replays all retained messages
+	// with a sequence number after fromSeq. If epoch is not the broker's current epoch, fromSeq belongs to
+	// a sequence that has started over and all retained messages are replayed. An empty epoch resumes
+	// in the current epoch, unless fromSeq is ahead of the broker's sequence, which has then started over.
+	SubscribeFrom(client *Client, epoch string, fromSeq uint64, topics ...string)
+
+	// Close stops the broker.
+	Close() error
 }
@@ -0,0 +1,91 @@
+package event
+
+import (
+	"slices"
+	"sort"
+)
+
+// historySize is the number of messages kept per topic for replays
+const historySize = 100
+
+// history keeps the latest messages of every topic in a bounded ring buffer per topic.
+// It is only accessed by the broker's event loop.
+type history struct {
+	size   int
+	topics map[string]*ring
+}
+
+// ring is a fixed size buffer that overwrites its oldest message once it is full
+type ring struct {
+	messages []Message
+	next     int
+}
+
+func newHistory(size int) *history {
+	return &history{
+		size:   size,
+		topics: make(map[string]*ring),
+	}
+}
+
+// add stores a message in the ring buffer of its topic
+func (h *history) add(message Message) {
+	buffer, ok := h.topics[message.Topic]
+	if !ok {
+		buffer = &ring{messages: make([]Message, 0, h.size)}
+		h.topics[message.Topic] = buffer
+	}
+
+	if len(buffer.messages) < h.size {
+		buffer.messages = append(buffer.messages, message)
+		return
+	}
+	buffer.messages[buffer.next] = message
+	buffer.next = (buffer.next + 1) % h.size
+}
+
+// since returns all retained messages of topics matching any of the patterns with a sequence number after seq,
+// ordered by sequence number
+func (h *history) since(patterns []string, seq uint64) []Message {
+	messages := make([]Message, 0)
+	for topic, buffer := range h.topics {
+		if !slices.ContainsFunc(patterns, func(pattern string) bool { return MatchTopic(pattern, topic) }) {
+			continue
+		}
+		for _, message := range buffer.messages {
+			if message.Seq > seq {
+				messages = append(messages, message)
+			}
+		}
+	}
+
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].Seq < messages[j].Seq
+	})
+	return messages
+}
+
+// mergeMessages adds the stored messages with a sequence number after fromSeq and up to toSeq to the retained messages.
+// A message that is both retained and stored is kept once. The result is ordered by sequence number.
+func mergeMessages(retained []Message, stored []Message, fromSeq uint64, toSeq uint64) []Message {
+	if len(stored) == 0 {
+		return retained
+	}
+
+	seen := make(map[uint64]bool, len(retained))
+	for _, message := range retained {
+		seen[message.Seq] = true
+	}
+	for _, message := range stored {
+		if message.Seq <= fromSeq || message.Seq > toSeq || seen[message.Seq] {
+			continue
+		}
+		seen[message.Seq] = true
+		retained = append(retained, message)
+	}
+
+	sort.Slice(retained, func(i, j int) bool {
+		return retained[i].Seq < retained[j].Seq
+	})
+	return retained
+}
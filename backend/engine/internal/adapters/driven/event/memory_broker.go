@@ -0,0 +1,154 @@
+package event
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// MemoryBroker manages client connections and distributes messages within a single process.
+type MemoryBroker struct {
+	Clients    map[*Client]bool
+	NewClients chan *Client
+	Defunct    chan *Client
+	Messages   chan Message
+	replays    chan replayRequest
+	history    *history
+	epoch      string
+	seq        uint64
+}
+
+// NewMemoryBroker creates a new in-memory message broker.
+// Its sequence starts over with every process, so every broker gets its own epoch.
+func NewMemoryBroker() *MemoryBroker {
+	return newMemoryBroker(strconv.FormatInt(time.Now().UnixNano(), 36))
+}
+
+// newMemoryBroker creates an in-memory message broker whose messages carry the given epoch
+func newMemoryBroker(epoch string) *MemoryBroker {
+	return &MemoryBroker{
+		Clients:    make(map[*Client]bool),
+		NewClients: make(chan *Client),
+		Defunct:    make(chan *Client),
+		Messages:   make(chan Message, 256),
+		replays:    make(chan replayRequest),
+		history:    newHistory(historySize),
+		epoch:      epoch,
+	}
+}
+
+// Start runs the broker's event loop in a goroutine.
+func (b *MemoryBroker) Start() {
+	go func() {
+		for {
+			select {
+			case client := <-b.NewClients:
+				b.Clients[client] = true
+
+			case client := <-b.Defunct:
+				if b.Clients[client] {
+					delete(b.Clients, client)
+					close(client.Send)
+				}
+
+			case replay := <-b.replays:
+				// Replaying inside the event loop guarantees that no live message is missed or sent twice
+				for _, topic := range replay.topics {
+					replay.client.SubscribeTo(topic)
+				}
+				fromSeq := replay.fromSeq
+				if replay.epoch != b.epoch && (replay.epoch != "" || replay.fromSeq > b.seq) {
+					fromSeq = 0
+				}
+				// Stored messages the broker has not received yet are left out, as they are delivered live on arrival
+				messages := mergeMessages(b.history.since(replay.topics, fromSeq), replay.stored, fromSeq, b.seq)
+				for _, message := range messages {
+					data, err := json.Marshal(message)
+					if err != nil {
+						continue
+					}
+					if !b.send(replay.client, data) {
+						break
+					}
+				}
+
+			case message := <-b.Messages:
+				// Messages received from other replicas already carry a sequence number
+				if message.Seq == 0 {
+					b.seq++
+					message.Seq = b.seq
+				} else {
+					b.seq = max(b.seq, message.Seq)
+				}
+				message.Epoch = b.epoch
+				b.history.add(message)
+
+				data, err := json.Marshal(message)
+				if err != nil {
+					continue
+				}
+
+				for client := range b.Clients {
+					if client.IsSubscribed(message.Topic) {
+						b.send(client, data)
+					}
+				}
+			}
+		}
+	}()
+}
+
+// send delivers data to a client. A client whose send buffer is full is removed and closed.
+func (b *MemoryBroker) send(client *Client, data []byte) bool {
+	if !b.Clients[client] {
+		return false
+	}
+
+	select {
+	case client.Send <- data:
+		return true
+	default:
+		delete(b.Clients, client)
+		close(client.Send)
+		return false
+	}
+}
+
+// Publish sends a message to all subscribers of a topic.
+func (b *MemoryBroker) Publish(topic string, payload any) error {
+	b.Messages <- Message{
+		Topic:     topic,
+		Payload:   payload,
+		Timestamp: time.Now(),
+	}
+	return nil
+}
+
+// Register adds a client that receives the messages of its subscribed topics.
+func (b *MemoryBroker) Register(client *Client) {
+	b.NewClients <- client
+}
+
+// Unregister removes a client and closes its send channel.
+func (b *MemoryBroker) Unregister(client *Client) {
+	b.Defunct <- client
+}
+
+// SubscribeFrom subscribes a client to topics and first replays all retained messages
+// with a sequence number after fromSeq, the last sequence number the client received.
+// A client that received fromSeq in another epoch, such as before a restart, is replayed all retained messages.
+// A client that does not know the epoch is only replayed all retained messages if fromSeq is ahead of the sequence.
+// A message matching several topics is replayed once.
+func (b *MemoryBroker) SubscribeFrom(client *Client, epoch string, fromSeq uint64, topics ...string) {
+	b.replays <- replayRequest{
+		client:  client,
+		topics:  topics,
+		epoch:   epoch,
+		fromSeq: fromSeq,
+	}
+}
+
+// Close releases the resources of the broker. The in-memory event loop lives as long as the process.
+func (b *MemoryBroker) Close() error {
+	return nil
+}
@@ -0,0 +1,169 @@
+package event
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestSubscribeFromReplaysMissedMessages(t *testing.T) {
+	broker := NewMemoryBroker()
+	broker.Start()
+
+	live := NewClient()
+	live.SubscribeTo("#")
+	broker.Register(live)
+
+	broker.Publish("tournament.1.created", "first")
+	broker.Publish("tournament.2.created", "second")
+	broker.Publish("tournament.1.paused", "third")
+	for range 3 {
+		receive(t, live)
+	}
+
+	client := NewClient()
+	broker.Register(client)
+	broker.SubscribeFrom(client, broker.epoch, 1, "tournament.1.#")
+
+	replayed := receive(t, client)
+	if replayed.Seq != 3 || replayed.Topic != "tournament.1.paused" || replayed.Timestamp.IsZero() {
+		t.Errorf("Expected the third message to be replayed, got %+v", replayed)
+	}
+
+	broker.Publish("tournament.1.resumed", "fourth")
+	if message := receive(t, client); message.Seq != 4 {
+		t.Errorf("Expected the live message with seq 4, got %+v", message)
+	}
+}
+
+func TestSubscribeFromReplaysAllMessagesOfAnotherEpoch(t *testing.T) {
+	broker := NewMemoryBroker()
+	broker.Start()
+
+	live := NewClient()
+	live.SubscribeTo("#")
+	broker.Register(live)
+
+	broker.Publish("tournament.1.created", "first")
+	broker.Publish("tournament.1.paused", "second")
+	for range 2 {
+		if message := receive(t, live); message.Epoch != broker.epoch {
+			t.Fatalf("Expected messages of epoch %s, got %+v", broker.epoch, message)
+		}
+	}
+
+	// The client received seq 5 from a process that has since been restarted
+	client := NewClient()
+	broker.Register(client)
+	broker.SubscribeFrom(client, "previous", 5, "tournament.1.#")
+
+	for _, seq := range []uint64{1, 2} {
+		if message := receive(t, client); message.Seq != seq {
+			t.Errorf("Expected message %d to be replayed, got %+v", seq, message)
+		}
+	}
+}
+
+func TestSubscribeFromWithoutEpoch(t *testing.T) {
+	broker := NewMemoryBroker()
+	broker.Start()
+
+	live := NewClient()
+	live.SubscribeTo("#")
+	broker.Register(live)
+
+	broker.Publish("tournament.1.created", "first")
+	broker.Publish("tournament.1.paused", "second")
+	for range 2 {
+		receive(t, live)
+	}
+
+	// An EventSource only knows the seq of the last event and resumes in the current epoch
+	resumed := NewClient()
+	broker.Register(resumed)
+	broker.SubscribeFrom(resumed, "", 1, "tournament.1.#")
+	if message := receive(t, resumed); message.Seq != 2 {
+		t.Errorf("Expected message 2 to be replayed, got %+v", message)
+	}
+
+	// A seq ahead of the broker was received before the sequence started over
+	restarted := NewClient()
+	broker.Register(restarted)
+	broker.SubscribeFrom(restarted, "", 5, "tournament.1.#")
+	for _, seq := range []uint64{1, 2} {
+		if message := receive(t, restarted); message.Seq != seq {
+			t.Errorf("Expected message %d to be replayed, got %+v", seq, message)
+		}
+	}
+}
+
+func TestSubscribeFromMergesStoredMessages(t *testing.T) {
+	broker := newMemoryBroker("postgres")
+	broker.Start()
+
+	live := NewClient()
+	live.SubscribeTo("#")
+	broker.Register(live)
+
+	// This replica received messages 2 and 4, another replica published 1, 3 and 5
+	broker.Messages <- Message{Seq: 2, Topic: "tournament.1.created"}
+	broker.Messages <- Message{Seq: 4, Topic: "tournament.1.paused"}
+	for range 2 {
+		receive(t, live)
+	}
+
+	client := NewClient()
+	broker.Register(client)
+	broker.replays <- replayRequest{
+		client:  client,
+		topics:  []string{"tournament.1.#"},
+		epoch:   "postgres",
+		fromSeq: 0,
+		stored: []Message{
+			{Seq: 1, Topic: "tournament.1.created"},
+			{Seq: 2, Topic: "tournament.1.created"},
+			{Seq: 3, Topic: "tournament.1.resumed"},
+			{Seq: 5, Topic: "tournament.1.completed"},
+		},
+	}
+
+	// Message 5 has not been received by this replica yet and is delivered live once it arrives
+	for _, seq := range []uint64{1, 2, 3, 4} {
+		if message := receive(t, client); message.Seq != seq {
+			t.Errorf("Expected message %d to be replayed, got %+v", seq, message)
+		}
+	}
+	select {
+	case data := <-client.Send:
+		t.Errorf("Expected no further replayed message, got %s", data)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestHistoryKeepsLatestMessagesPerTopic(t *testing.T) {
+	history := newHistory(2)
+	for seq := uint64(1); seq <= 3; seq++ {
+		history.add(Message{Seq: seq, Topic: "tournament.1.created"})
+	}
+	history.add(Message{Seq: 4, Topic: "tournament.2.created"})
+
+	messages := history.since([]string{"tournament.*.created", "tournament.2.#"}, 0)
+	if len(messages) != 3 || messages[0].Seq != 2 || messages[1].Seq != 3 || messages[2].Seq != 4 {
+		t.Errorf("Expected messages 2, 3 and 4, got %+v", messages)
+	}
+}
+
+func receive(t *testing.T, client *Client) Message {
+	t.Helper()
+	select {
+	case data := <-client.Send:
+		var message Message
+		if err := json.Unmarshal(data, &message); err != nil {
+			t.Fatalf("Failed to decode message: %v", err)
+		}
+		return message
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected a message")
+		return Message{}
+	}
+}
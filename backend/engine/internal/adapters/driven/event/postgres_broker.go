@@ -0,0 +1,429 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	// notifyChannel is the Postgres channel that announces new broker messages
+	notifyChannel = "broker_messages"
+	// messageRetention is how long published messages are kept for replicas catching up
+	messageRetention = time.Hour
+	// listenerPingInterval is how often the listener connection is checked and missed messages are fetched
+	listenerPingInterval = 90 * time.Second
+	// gapTimeout is how long a missing message id is waited for before it is skipped. Ids are assigned on insert,
+	// so a message may commit after one with a higher id, or never if its transaction is rolled back.
+	// Skipped ids are still fetched until their transaction has certainly ended, see brokerQueryTimeout.
+	gapTimeout = 5 * time.Second
+	// gapRetryInterval is how often messages are fetched again while a missing message id is waited for
+	gapRetryInterval = 100 * time.Millisecond
+	// brokerQueryTimeout is the timeout of the queries of the Postgres broker. A publishing transaction
+	// cannot commit later than this after its message id was assigned.
+	brokerQueryTimeout = 30 * time.Second
+	// postgresEpoch is the epoch of all replicas. Sequence numbers are the ids of broker_messages,
+	// which survive restarts and are shared by all replicas.
+	postgresEpoch = "postgres"
+)
+
+// PostgresBroker fans out messages across all replicas of the engine using Postgres LISTEN/NOTIFY.
+// Messages are stored in the broker_messages table and only their arrival is notified, which avoids the
+// payload limit of NOTIFY and lets replicas fetch messages they missed while reconnecting.
+// The id of a stored message is its sequence number, so clients can resume on any replica.
+// Every replica delivers the messages to its own clients through an in-memory broker.
+type PostgresBroker struct {
+	local    *MemoryBroker
+	db       *sql.DB
+	listener *pq.Listener
+	lastSeq  uint64
+	gapSince time.Time
+	// skipped holds the ids that were skipped after gapTimeout and when they were skipped
+	skipped map[uint64]time.Time
+	done    chan struct{}
+}
+
+// NewPostgresBroker creates a broker that listens for messages of all replicas using the given connection string.
+func NewPostgresBroker(db *sql.DB, connectionString string) (*PostgresBroker, error) {
+	if db == nil {
+		return nil, errors.New("db cannot be nil")
+	}
+
+	listener := pq.NewListener(connectionString, 10*time.Millisecond, time.Minute, logListenerEvent)
+	if err := listener.Listen(notifyChannel); err != nil {
+		listener.Close()
+		return nil, fmt.Errorf("error listening on %s: %w", notifyChannel, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), brokerQueryTimeout)
+	defer cancel()
+
+	// Only messages published after the broker started listening are delivered. The sequence is read instead of
+	// the stored messages, as all messages may have been pruned.
+	var lastSeq uint64
+	query := `
+		SELECT CASE WHEN is_called THEN last_value ELSE last_value - 1 END
+		FROM broker_messages_id_seq
+	`
+	err := db.QueryRowContext(ctx, query).Scan(&lastSeq)
+	if err != nil {
+		listener.Close()
+		return nil, fmt.Errorf("error querying broker messages: %w", err)
+	}
+
+	// The local broker starts at the same sequence number, so it replays stored messages published before it started
+	local := newMemoryBroker(postgresEpoch)
+	local.seq = lastSeq
+
+	return &PostgresBroker{
+		local:    local,
+		db:       db,
+		listener: listener,
+		lastSeq:  lastSeq,
+		skipped:  make(map[uint64]time.Time),
+		done:     make(chan struct{}),
+	}, nil
+}
+
+// Start runs the local event loop and the listener in goroutines.
+func (b *PostgresBroker) Start() {
+	b.local.Start()
+	go b.listen()
+}
+
+// Publish stores a message and notifies all replicas, including this one.
+func (b *PostgresBroker) Publish(topic string, payload any) (err error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("error encoding message on %s: %w", topic, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), brokerQueryTimeout)
+	defer cancel()
+
+	tx, err := b.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				err = errors.Join(err, fmt.Errorf("rollback failed: %w", rollbackErr))
+			}
+		}
+	}()
+
+	query := `
+		INSERT INTO broker_messages (topic, payload, published_at)
+		VALUES ($1, $2, $3)
+	`
+	if _, err = tx.ExecContext(ctx, query, topic, string(data), time.Now().UTC()); err != nil {
+		return fmt.Errorf("error saving message on %s: %w", topic, err)
+	}
+
+	// The notification is sent when the transaction commits
+	if _, err = tx.ExecContext(ctx, `SELECT pg_notify($1, '')`, notifyChannel); err != nil {
+		return fmt.Errorf("error notifying %s: %w", notifyChannel, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Register adds a client that receives the messages of its subscribed topics.
+func (b *PostgresBroker) Register(client *Client) {
+	b.local.Register(client)
+}
+
+// Unregister removes a client and closes its send channel.
+func (b *PostgresBroker) Unregister(client *Client) {
+	b.local.Unregister(client)
+}
+
+// SubscribeFrom subscribes a client to topics and first replays all retained messages
+// with a sequence number after fromSeq. The messages are replayed from broker_messages,
+// so a client can resume on a replica that never received the messages it missed.
+func (b *PostgresBroker) SubscribeFrom(client *Client, epoch string, fromSeq uint64, topics ...string) {
+	// The sequence never starts over, so a client that does not know the epoch resumes after fromSeq
+	if epoch == "" {
+		epoch = postgresEpoch
+	}
+
+	storedFromSeq := fromSeq
+	if epoch != postgresEpoch {
+		storedFromSeq = 0
+	}
+
+	// If the stored messages cannot be loaded, the messages received by this replica are still replayed
+	stored, err := b.storedMessages(storedFromSeq, topics)
+	if err != nil {
+		log.Printf("failed to load broker messages for replay: %v", err)
+	}
+
+	b.local.replays <- replayRequest{
+		client:  client,
+		topics:  topics,
+		epoch:   epoch,
+		fromSeq: fromSeq,
+		stored:  stored,
+	}
+}
+
+// storedMessages returns the stored messages with a sequence number after fromSeq whose topics match any of the patterns
+func (b *PostgresBroker) storedMessages(fromSeq uint64, patterns []string) ([]Message, error) {
+	if len(patterns) == 0 {
+		return nil, nil
+	}
+
+	prefixes := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		prefixes = append(prefixes, likePrefix(pattern))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), brokerQueryTimeout)
+	defer cancel()
+
+	query := `
+		SELECT id, topic, payload, published_at
+		FROM broker_messages
+		WHERE id > $1 AND topic LIKE ANY($2)
+		ORDER BY id
+	`
+	rows, err := b.db.QueryContext(ctx, query, fromSeq, pq.Array(prefixes))
+	if err != nil {
+		return nil, fmt.Errorf("error querying broker messages: %w", err)
+	}
+	defer closeRows(rows)
+
+	messages := make([]Message, 0)
+	for rows.Next() {
+		message, err := scanMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		if slices.ContainsFunc(patterns, func(pattern string) bool { return MatchTopic(pattern, message.Topic) }) {
+			message.Epoch = postgresEpoch
+			messages = append(messages, message)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating broker messages: %w", err)
+	}
+	return messages, nil
+}
+
+// Close stops the listener.
+func (b *PostgresBroker) Close() error {
+	close(b.done)
+	return b.listener.Close()
+}
+
+// listen fetches new messages whenever a replica notifies or the listener reconnects
+func (b *PostgresBroker) listen() {
+	ticker := time.NewTicker(listenerPingInterval)
+	defer ticker.Stop()
+
+	// retry is set while a missing message id is waited for
+	var retry <-chan time.Time
+	receive := func() {
+		retry = nil
+		if b.receive() {
+			retry = time.After(gapRetryInterval)
+		}
+	}
+
+	for {
+		select {
+		case <-b.done:
+			return
+		case <-b.listener.Notify:
+			// A nil notification after a reconnect is handled the same way, as notifications may have been lost
+			receive()
+		case <-retry:
+			receive()
+		case <-ticker.C:
+			if err := b.listener.Ping(); err != nil {
+				log.Printf("broker listener ping failed: %v", err)
+			}
+			receive()
+			b.prune()
+		}
+	}
+}
+
+// receive hands all messages stored since the last received one to the local broker in sequence order.
+// Messages are only handed over without gaps, so a message that commits after one with a higher id is not skipped.
+// It reports whether a missing message id is still waited for.
+func (b *PostgresBroker) receive() bool {
+	b.receiveSkipped(time.Now())
+
+	ctx, cancel := context.WithTimeout(context.Background(), brokerQueryTimeout)
+	defer cancel()
+
+	query := `
+		SELECT id, topic, payload, published_at
+		FROM broker_messages
+		WHERE id > $1
+		ORDER BY id
+	`
+	rows, err := b.db.QueryContext(ctx, query, b.lastSeq)
+	if err != nil {
+		log.Printf("failed to query broker messages: %v", err)
+		return false
+	}
+	defer closeRows(rows)
+
+	for rows.Next() {
+		message, err := scanMessage(rows)
+		if err != nil {
+			log.Printf("failed to scan broker message: %v", err)
+			return false
+		}
+
+		if !b.acceptSeq(message.Seq, time.Now()) {
+			return true
+		}
+		b.local.Messages <- message
+		b.lastSeq = message.Seq
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Printf("failed to iterate broker messages: %v", err)
+	}
+	return false
+}
+
+// acceptSeq reports whether the message with the given id may be handed over after the last received one.
+// A message following a gap is held back until the gap has been open for gapTimeout.
+// The ids of the gap are then remembered, so their messages are still handed over if they commit later.
+func (b *PostgresBroker) acceptSeq(seq uint64, now time.Time) bool {
+	if seq > b.lastSeq+1 {
+		if b.gapSince.IsZero() {
+			b.gapSince = now
+		}
+		if now.Sub(b.gapSince) < gapTimeout {
+			return false
+		}
+		log.Printf("skipping missing broker messages %d to %d", b.lastSeq+1, seq-1)
+		for skipped := b.lastSeq + 1; skipped < seq; skipped++ {
+			b.skipped[skipped] = now
+		}
+	}
+	b.gapSince = time.Time{}
+	return true
+}
+
+// receiveSkipped hands skipped messages that have committed since to the local broker.
+// An id is forgotten once its transaction has certainly ended, as it was then rolled back.
+func (b *PostgresBroker) receiveSkipped(now time.Time) {
+	ids := b.pendingSkipped(now)
+	if len(ids) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), brokerQueryTimeout)
+	defer cancel()
+
+	query := `
+		SELECT id, topic, payload, published_at
+		FROM broker_messages
+		WHERE id = ANY($1)
+		ORDER BY id
+	`
+	rows, err := b.db.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		log.Printf("failed to query skipped broker messages: %v", err)
+		return
+	}
+	defer closeRows(rows)
+
+	for rows.Next() {
+		message, err := scanMessage(rows)
+		if err != nil {
+			log.Printf("failed to scan broker message: %v", err)
+			return
+		}
+		b.local.Messages <- message
+		delete(b.skipped, message.Seq)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Printf("failed to iterate skipped broker messages: %v", err)
+	}
+}
+
+// pendingSkipped forgets the skipped ids whose transaction has certainly ended and returns the remaining ones
+func (b *PostgresBroker) pendingSkipped(now time.Time) []int64 {
+	ids := make([]int64, 0, len(b.skipped))
+	for seq, skippedAt := range b.skipped {
+		if now.Sub(skippedAt) >= brokerQueryTimeout {
+			delete(b.skipped, seq)
+			continue
+		}
+		ids = append(ids, int64(seq))
+	}
+	return ids
+}
+
+// prune removes messages that are older than the retention period
+func (b *PostgresBroker) prune() {
+	ctx, cancel := context.WithTimeout(context.Background(), brokerQueryTimeout)
+	defer cancel()
+
+	_, err := b.db.ExecContext(ctx, `DELETE FROM broker_messages WHERE published_at < $1`, time.Now().UTC().Add(-messageRetention))
+	if err != nil {
+		log.Printf("failed to prune broker messages: %v", err)
+	}
+}
+
+// scanMessage reads a stored message from the current row
+func scanMessage(rows *sql.Rows) (Message, error) {
+	var payload json.RawMessage
+	message := Message{}
+	if err := rows.Scan(&message.Seq, &message.Topic, &payload, &message.Timestamp); err != nil {
+		return Message{}, err
+	}
+	message.Payload = payload
+	return message, nil
+}
+
+// likeEscaper escapes the special characters of a LIKE pattern
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// likePrefix returns a LIKE pattern matching at least all topics that match the subscription pattern.
+// It matches the segments before the first wildcard as a prefix, the exact match is checked with MatchTopic.
+func likePrefix(pattern string) string {
+	literal := make([]string, 0)
+	for _, segment := range strings.Split(pattern, topicSeparator) {
+		if segment == singleSegmentWildcard || segment == multiSegmentWildcard {
+			break
+		}
+		literal = append(literal, segment)
+	}
+	return likeEscaper.Replace(strings.Join(literal, topicSeparator)) + "%"
+}
+
+func logListenerEvent(event pq.ListenerEventType, err error) {
+	if err != nil {
+		log.Printf("broker listener event %d: %v", event, err)
+	}
+}
+
+func closeRows(rows *sql.Rows) {
+	if err := rows.Close(); err != nil {
+		log.Printf("failed to close rows: %v", err)
+	}
+}
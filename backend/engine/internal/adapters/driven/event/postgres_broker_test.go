@@ -0,0 +1,117 @@
+package event
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+)
+
+// testConnectionString returns the connection string of the local Postgres used by the broker tests
+func testConnectionString() string {
+	if connectionString := os.Getenv("TEST_DATABASE_URL"); connectionString != "" {
+		return connectionString
+	}
+	return "host=localhost port=5432 user=postgres password=postgres dbname=tournament sslmode=disable"
+}
+
+func TestPostgresBrokerFansOutAcrossReplicas(t *testing.T) {
+	connectionString := testConnectionString()
+	db, err := sql.Open("postgres", connectionString)
+	if err != nil {
+		t.Skipf("Postgres is not available: %v", err)
+	}
+	defer db.Close()
+	if err := db.Ping(); err != nil {
+		t.Skipf("Postgres is not available: %v", err)
+	}
+
+	replicaA, err := NewPostgresBroker(db, connectionString)
+	if err != nil {
+		t.Skipf("Postgres broker is not available, are the migrations applied? %v", err)
+	}
+	defer replicaA.Close()
+	replicaB, err := NewPostgresBroker(db, connectionString)
+	if err != nil {
+		t.Fatalf("Failed to create second broker: %v", err)
+	}
+	defer replicaB.Close()
+	replicaA.Start()
+	replicaB.Start()
+
+	topic := fmt.Sprintf("test.%d.created", time.Now().UnixNano())
+	client := NewClient()
+	client.SubscribeTo(topic)
+	replicaB.Register(client)
+
+	if err := replicaA.Publish(topic, map[string]string{"name": "Cup"}); err != nil {
+		t.Fatalf("Failed to publish: %v", err)
+	}
+
+	message := receive(t, client)
+	if message.Topic != topic || message.Seq == 0 {
+		t.Errorf("Expected a message on %s with a sequence number, got %+v", topic, message)
+	}
+	if payload, ok := message.Payload.(map[string]any); !ok || payload["name"] != "Cup" {
+		t.Errorf("Expected the published payload, got %+v", message.Payload)
+	}
+}
+
+func TestPostgresBrokerWaitsForMissingMessages(t *testing.T) {
+	broker := &PostgresBroker{lastSeq: 4, skipped: make(map[uint64]time.Time)}
+	now := time.Now()
+
+	if !broker.acceptSeq(5, now) {
+		t.Fatal("Expected the next message to be accepted")
+	}
+	broker.lastSeq = 5
+
+	// Message 6 has not been committed yet, so message 7 is held back
+	if broker.acceptSeq(7, now) {
+		t.Error("Expected a message after a gap to be held back")
+	}
+	if broker.acceptSeq(7, now.Add(gapTimeout/2)) {
+		t.Error("Expected a message after a gap to be held back until the gap timed out")
+	}
+	if !broker.acceptSeq(6, now.Add(gapTimeout/2)) {
+		t.Error("Expected the missing message to be accepted once committed")
+	}
+	broker.lastSeq = 6
+	if !broker.acceptSeq(7, now.Add(gapTimeout/2)) {
+		t.Error("Expected the held back message to be accepted once the gap closed")
+	}
+	broker.lastSeq = 7
+
+	// Message 8 was rolled back and is skipped after the timeout
+	if broker.acceptSeq(9, now) {
+		t.Error("Expected a message after a gap to be held back")
+	}
+	if !broker.acceptSeq(9, now.Add(gapTimeout)) {
+		t.Error("Expected a message after a timed out gap to be accepted")
+	}
+
+	// Message 8 is still fetched in case it commits late, until its transaction has certainly ended
+	if ids := broker.pendingSkipped(now.Add(gapTimeout)); len(ids) != 1 || ids[0] != 8 {
+		t.Errorf("Expected message 8 to be fetched again, got %v", ids)
+	}
+	if ids := broker.pendingSkipped(now.Add(gapTimeout + brokerQueryTimeout)); len(ids) != 0 {
+		t.Errorf("Expected message 8 to be forgotten after the query timeout, got %v", ids)
+	}
+}
+
+func TestLikePrefix(t *testing.T) {
+	tests := map[string]string{
+		"tournament.1.created": "tournament.1.created%",
+		"tournament.*.created": "tournament%",
+		"tournament.1_a.#":     `tournament.1\_a%`,
+		"#":                    "%",
+	}
+	for pattern, expected := range tests {
+		if prefix := likePrefix(pattern); prefix != expected {
+			t.Errorf("Expected %s to become %s, got %s", pattern, expected, prefix)
+		}
+	}
+}
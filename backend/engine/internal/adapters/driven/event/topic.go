@@ -0,0 +1,53 @@
+package event
+
+import (
+	"strings"
+)
+
+const (
+	// topicSeparator separates the segments of a hierarchical topic
+	topicSeparator = "."
+	// singleSegmentWildcard matches exactly one segment of a topic
+	singleSegmentWildcard = "*"
+	// multiSegmentWildcard matches zero or more segments of a topic
+	multiSegmentWildcard = "#"
+)
+
+// TournamentTopic builds the topic of an event of a tournament, e.g. tournament.{id}.match.{matchId}.placements
+func TournamentTopic(tournamentId string, segments ...string) string {
+	return strings.Join(append([]string{"tournament", tournamentId}, segments...), topicSeparator)
+}
+
+// MatchTopic reports whether a topic matches a subscription pattern.
+// A "*" segment matches exactly one segment and a "#" segment matches zero or more segments,
+// so "tournament.*.created" matches every created tournament and "tournament.{id}.#" every event of one tournament.
+func MatchTopic(pattern string, topic string) bool {
+	if pattern == topic {
+		return true
+	}
+	return matchSegments(strings.Split(pattern, topicSeparator), strings.Split(topic, topicSeparator))
+}
+
+func matchSegments(pattern []string, topic []string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case multiSegmentWildcard:
+			for skip := 0; skip <= len(topic); skip++ {
+				if matchSegments(pattern[1:], topic[skip:]) {
+					return true
+				}
+			}
+			return false
+		case singleSegmentWildcard:
+			if len(topic) == 0 {
+				return false
+			}
+		default:
+			if len(topic) == 0 || pattern[0] != topic[0] {
+				return false
+			}
+		}
+		pattern, topic = pattern[1:], topic[1:]
+	}
+	return len(topic) == 0
+}
@@ -0,0 +1,31 @@
+package event
+
+import (
+	"testing"
+)
+
+func TestMatchTopic(t *testing.T) {
+	tests := []struct {
+		pattern string
+		topic   string
+		matches bool
+	}{
+		{"tournament.1.created", "tournament.1.created", true},
+		{"tournament.1.created", "tournament.2.created", false},
+		{"tournament.*.created", "tournament.2.created", true},
+		{"tournament.*.created", "tournament.2.match.3.placements", false},
+		{"tournament.1.#", "tournament.1.match.3.placements", true},
+		{"tournament.1.#", "tournament.1", true},
+		{"tournament.1.#", "tournament.2.created", false},
+		{"tournament.*.match.*.placements", "tournament.1.match.3.placements", true},
+		{"tournament.#.placements", "tournament.1.match.3.placements", true},
+		{"#", "tournament.1.created", true},
+		{"tournament.*", "tournament.1.created", false},
+	}
+
+	for _, test := range tests {
+		if MatchTopic(test.pattern, test.topic) != test.matches {
+			t.Errorf("Expected MatchTopic(%q, %q) to be %t", test.pattern, test.topic, test.matches)
+		}
+	}
+}
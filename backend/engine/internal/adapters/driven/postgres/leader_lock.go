@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"engine/internal/ports/output"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+)
+
+type LeaderLock struct {
+	db *sql.DB
+}
+
+// NewLeaderLock creates a leader lock based on PostgreSQL session-level advisory locks
+func NewLeaderLock(db *sql.DB) (output.LeaderLockInterface, error) {
+	if db == nil {
+		return nil, errors.New("db cannot be nil")
+	}
+	return &LeaderLock{
+		db: db,
+	}, nil
+}
+
+// RunAsLeader runs fn while this replica holds the lock with the given name and blocks until ctx is cancelled.
+// The advisory lock belongs to a dedicated connection, so it is released when the connection is lost,
+// which is checked every retryInterval.
+func (l *LeaderLock) RunAsLeader(ctx context.Context, name string, retryInterval time.Duration, fn func(ctx context.Context)) {
+	ticker := time.NewTicker(retryInterval)
+	defer ticker.Stop()
+
+	for {
+		conn, err := l.acquire(ctx, name)
+		if err != nil {
+			log.Printf("failed to acquire leader lock %s: %v", name, err)
+		}
+		if conn != nil {
+			l.lead(ctx, conn, name, ticker.C, fn)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// acquire tries to take the lock on a dedicated connection. It returns nil if another replica holds the lock.
+func (l *LeaderLock) acquire(ctx context.Context, name string) (*sql.Conn, error) {
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error opening connection: %w", err)
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	var acquired bool
+	err = conn.QueryRowContext(queryCtx, `SELECT pg_try_advisory_lock(hashtext($1))`, name).Scan(&acquired)
+	if err != nil || !acquired {
+		l.closeConn(conn)
+		return nil, err
+	}
+	return conn, nil
+}
+
+// lead runs fn until ctx is cancelled or the connection holding the lock is lost.
+// fn has returned before the lock is released by discarding the connection.
+func (l *LeaderLock) lead(ctx context.Context, conn *sql.Conn, name string, check <-chan time.Time, fn func(ctx context.Context)) {
+	defer l.discardConn(conn)
+
+	leaderCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn(leaderCtx)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-check:
+			queryCtx, cancelQuery := context.WithTimeout(ctx, queryTimeout)
+			err := conn.PingContext(queryCtx)
+			cancelQuery()
+			if err != nil {
+				log.Printf("lost leader lock %s: %v", name, err)
+				return
+			}
+		}
+	}
+}
+
+// discardConn closes the connection instead of returning it to the pool, which ends the session and
+// releases its advisory lock
+func (l *LeaderLock) discardConn(conn *sql.Conn) {
+	err := conn.Raw(func(any) error {
+		return driver.ErrBadConn
+	})
+	if err != nil && !errors.Is(err, driver.ErrBadConn) {
+		log.Printf("failed to discard connection: %v", err)
+	}
+}
+
+func (l *LeaderLock) closeConn(conn *sql.Conn) {
+	if err := conn.Close(); err != nil {
+		log.Printf("failed to close connection: %v", err)
+	}
+}
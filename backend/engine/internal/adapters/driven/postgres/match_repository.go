@@ -0,0 +1,182 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"engine/internal/domain"
+	"engine/internal/ports/output"
+	"errors"
+	"fmt"
+)
+
+type MatchRepository struct {
+	db *sql.DB
+}
+
+// NewMatchRepository creates a new PostgreSQL match repository
+func NewMatchRepository(db *sql.DB) (output.MatchRepositoryInterface, error) {
+	if db == nil {
+		return nil, errors.New("db cannot be nil")
+	}
+	return &MatchRepository{
+		db: db,
+	}, nil
+}
+
+// ReplacePlacements atomically replaces all placements of a match
+func (r *MatchRepository) ReplacePlacements(ctx context.Context, matchId string, placements []domain.Placement) ([]domain.Placement, error) {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	saved := make([]domain.Placement, 0, len(placements))
+	err := inTransaction(ctx, r.db, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `DELETE FROM placements WHERE match_id = $1`, matchId)
+		if err != nil {
+			return fmt.Errorf("error deleting placements: %w", err)
+		}
+
+		for _, placement := range placements {
+			query := `
+				INSERT INTO placements (match_id, player_id, placement)
+				VALUES ($1, $2, $3)
+				RETURNING id
+			`
+			placement.MatchId = matchId
+			err = tx.QueryRowContext(ctx, query, matchId, placement.PlayerId, placement.Placement).Scan(&placement.Id)
+			if err != nil {
+				return fmt.Errorf("error saving placement: %w", err)
+			}
+			saved = append(saved, placement)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return saved, nil
+}
+
+// InsertMatches persists additional matches of a group including their participants
+func (r *MatchRepository) InsertMatches(ctx context.Context, groupId string, matches []domain.Match) ([]domain.Match, error) {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	err := inTransaction(ctx, r.db, func(tx *sql.Tx) error {
+		for i := range matches {
+			if err := insertMatch(ctx, tx, &matches[i], groupId); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
+
+// SaveProgress persists the participants, maps and new placements of matches that advanced in a bracket
+func (r *MatchRepository) SaveProgress(ctx context.Context, matches []*domain.Match) error {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	return inTransaction(ctx, r.db, func(tx *sql.Tx) error {
+		for _, match := range matches {
+			query := `
+				UPDATE matches
+				SET map_name = NULLIF($2, ''), map_picker_id = NULLIF($3, '')::uuid
+				WHERE id = $1
+			`
+			_, err := tx.ExecContext(ctx, query, match.Id, match.MapName, match.MapPickerId)
+			if err != nil {
+				return fmt.Errorf("error updating match map: %w", err)
+			}
+			if err := saveMatchProgress(ctx, tx, match); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// UpdateMapName stores the map a match is played on
+func (r *MatchRepository) UpdateMapName(ctx context.Context, matchId string, mapName string) error {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	result, err := connFor(ctx, r.db).ExecContext(ctx, `UPDATE matches SET map_name = $2 WHERE id = $1`, matchId, mapName)
+	if err != nil {
+		return fmt.Errorf("error updating map: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error updating map: %w", err)
+	}
+	if rows == 0 {
+		return domain.NewNotFoundError("match not found")
+	}
+	return nil
+}
+
+// insertMatch persists a match with its participants and any placements known in advance, such as byes
+func insertMatch(ctx context.Context, tx *sql.Tx, match *domain.Match, groupId string) error {
+	query := `
+		INSERT INTO matches (group_id, position, stage, bracket, map_name, map_picker_id, next_match_position, loser_next_match_position)
+		VALUES ($1, $2, $3, NULLIF($4, ''), NULLIF($5, ''), NULLIF($6, '')::uuid, $7, $8)
+		RETURNING id
+	`
+	err := tx.QueryRowContext(
+		ctx,
+		query,
+		groupId,
+		match.Position,
+		match.Stage,
+		match.Bracket,
+		match.MapName,
+		match.MapPickerId,
+		match.NextMatchPosition,
+		match.LoserNextMatchPosition,
+	).Scan(&match.Id)
+	if err != nil {
+		return fmt.Errorf("error saving match: %w", err)
+	}
+	match.GroupId = groupId
+
+	return saveMatchProgress(ctx, tx, match)
+}
+
+// saveMatchProgress persists the participants of a match and all placements that are not stored yet
+func saveMatchProgress(ctx context.Context, tx *sql.Tx, match *domain.Match) error {
+	for seed, player := range match.Players {
+		query := `
+			INSERT INTO match_players (match_id, player_id, seed)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (match_id, player_id) DO UPDATE SET seed = EXCLUDED.seed
+		`
+		_, err := tx.ExecContext(ctx, query, match.Id, player.Id, seed)
+		if err != nil {
+			return fmt.Errorf("error assigning player to match: %w", err)
+		}
+	}
+
+	for i := range match.Placements {
+		placement := &match.Placements[i]
+		if placement.Id != "" {
+			continue
+		}
+		query := `
+			INSERT INTO placements (match_id, player_id, placement)
+			VALUES ($1, $2, $3)
+			RETURNING id
+		`
+		err := tx.QueryRowContext(ctx, query, match.Id, placement.PlayerId, placement.Placement).Scan(&placement.Id)
+		if err != nil {
+			return fmt.Errorf("error saving placement: %w", err)
+		}
+		placement.MatchId = match.Id
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"engine/internal/domain"
+	"engine/internal/ports/output"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/lib/pq"
+)
+
+type OutboxRepository struct {
+	db *sql.DB
+}
+
+// NewOutboxRepository creates a new PostgreSQL outbox repository
+func NewOutboxRepository(db *sql.DB) (output.OutboxRepositoryInterface, error) {
+	if db == nil {
+		return nil, errors.New("db cannot be nil")
+	}
+	return &OutboxRepository{
+		db: db,
+	}, nil
+}
+
+// InsertEvents writes the recorded events of a tournament to the outbox and clears them.
+// Within a running transaction the events are committed together with the changes that caused them.
+func (r *OutboxRepository) InsertEvents(ctx context.Context, tournament *domain.Tournament) error {
+	if len(tournament.Events) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	err := inTransaction(ctx, r.db, func(tx *sql.Tx) error {
+		return insertOutboxEvents(ctx, tx, tournament)
+	})
+	if err != nil {
+		return err
+	}
+
+	tournament.Events = nil
+	return nil
+}
+
+// FindPending returns up to limit events that have not been dispatched yet, oldest first.
+// The events are locked until the running transaction ends, and events locked by another replica are skipped.
+func (r *OutboxRepository) FindPending(ctx context.Context, limit int) ([]domain.OutboxEvent, error) {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	query := `
+		SELECT id, tournament_id, name, payload, created_at
+		FROM outbox_events
+		WHERE dispatched_at IS NULL
+		ORDER BY id
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	`
+	rows, err := connFor(ctx, r.db).QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error querying outbox events: %w", err)
+	}
+	defer r.closeRows(rows)
+
+	events := make([]domain.OutboxEvent, 0)
+	for rows.Next() {
+		event := domain.OutboxEvent{}
+		err := rows.Scan(&event.Id, &event.TournamentId, &event.Name, &event.Payload, &event.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning outbox event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating outbox events: %w", err)
+	}
+
+	return events, nil
+}
+
+// MarkDispatched records that the given events have been published
+func (r *OutboxRepository) MarkDispatched(ctx context.Context, ids []int64) error {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	query := `
+		UPDATE outbox_events
+		SET dispatched_at = NOW()
+		WHERE id = ANY($1)
+	`
+	_, err := connFor(ctx, r.db).ExecContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("error marking outbox events as dispatched: %w", err)
+	}
+	return nil
+}
+
+func (r *OutboxRepository) closeRows(rows *sql.Rows) {
+	if err := rows.Close(); err != nil {
+		log.Printf("failed to close rows: %v", err)
+	}
+}
+
+// insertOutboxEvents writes the recorded events of a tournament to the outbox within the given transaction
+func insertOutboxEvents(ctx context.Context, tx *sql.Tx, tournament *domain.Tournament) error {
+	query := `
+		INSERT INTO outbox_events (tournament_id, name, payload)
+		VALUES ($1, $2, $3)
+	`
+	for _, event := range tournament.Events {
+		payload, err := json.Marshal(event.Payload)
+		if err != nil {
+			return fmt.Errorf("error encoding event %s: %w", event.Name, err)
+		}
+
+		_, err = tx.ExecContext(ctx, query, tournament.Id, event.Name, string(payload))
+		if err != nil {
+			return fmt.Errorf("error saving event %s: %w", event.Name, err)
+		}
+	}
+	return nil
+}
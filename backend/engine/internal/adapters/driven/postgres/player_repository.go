@@ -34,14 +34,14 @@ func (r *PlayerRepository) InsertNewPlayer(ctx context.Context, player *domain.P
 	query := `
 		INSERT INTO players (name, tournament_id)
 		VALUES ($1, $2)
-		RETURNING id
+		RETURNING id, status
 	`
-	err := r.db.QueryRowContext(
+	err := connFor(ctx, r.db).QueryRowContext(
 		ctx,
 		query,
 		player.Name,
 		player.TournamentId,
-	).Scan(&playerID)
+	).Scan(&playerID, &player.Status)
 
 	if err != nil {
 		return nil, fmt.Errorf("error saving player: %w", err)
@@ -57,7 +57,7 @@ func (r *PlayerRepository) Delete(ctx context.Context, id string) error {
 	defer cancel()
 
 	query := `DELETE FROM players WHERE id = $1`
-	result, err := r.db.ExecContext(ctx, query, id)
+	result, err := connFor(ctx, r.db).ExecContext(ctx, query, id)
 
 	if err != nil {
 		return fmt.Errorf("error deleting player: %w", err)
@@ -80,11 +80,12 @@ func (r *PlayerRepository) FindAll(ctx context.Context, tournamentId string) ([]
 	defer cancel()
 
 	query := `
-		SELECT id, name, tournament_id
+		SELECT id, name, tournament_id, status
 		FROM players
 		WHERE tournament_id = $1
+		ORDER BY created_at
 	`
-	rows, err := r.db.QueryContext(ctx, query, tournamentId)
+	rows, err := connFor(ctx, r.db).QueryContext(ctx, query, tournamentId)
 	if err != nil {
 		return nil, fmt.Errorf("error querying players: %w", err)
 	}
@@ -97,6 +98,7 @@ func (r *PlayerRepository) FindAll(ctx context.Context, tournamentId string) ([]
 			&player.Id,
 			&player.Name,
 			&player.TournamentId,
+			&player.Status,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("error scanning player: %w", err)
@@ -116,17 +118,18 @@ func (r *PlayerRepository) FindByID(ctx context.Context, id string) (*domain.Pla
 	defer cancel()
 
 	query := `
-		SELECT id, name, tournament_id
+		SELECT id, name, tournament_id, status
 		FROM players
 		WHERE id = $1
 	`
-	row := r.db.QueryRowContext(ctx, query, id)
+	row := connFor(ctx, r.db).QueryRowContext(ctx, query, id)
 
 	player := new(domain.Player)
 	err := row.Scan(
 		&player.Id,
 		&player.Name,
 		&player.TournamentId,
+		&player.Status,
 	)
 
 	if err != nil {
@@ -142,43 +145,34 @@ func (r *PlayerRepository) FindByID(ctx context.Context, id string) (*domain.Pla
 	return player, nil
 }
 
-func (r *PlayerRepository) UpdateName(ctx context.Context, player *domain.Player) (_ *domain.Player, err error) {
+func (r *PlayerRepository) UpdateName(ctx context.Context, player *domain.Player) (*domain.Player, error) {
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
-	if err != nil {
-		return nil, fmt.Errorf("error starting transaction: %w", err)
-	}
+	err := inTransaction(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			UPDATE players
+			SET name = $1
+			WHERE id = $2
+		`
 
-	defer func() {
+		result, err := tx.ExecContext(ctx, query, player.Name, player.Id)
 		if err != nil {
-			tx.Rollback()
+			return fmt.Errorf("error updating player: %w", err)
 		}
-	}()
-
-	query := `
-		UPDATE players
-		SET name = $1
-		WHERE id = $2
-	`
 
-	result, err := tx.ExecContext(ctx, query, player.Name, player.Id)
-	if err != nil {
-		return nil, fmt.Errorf("error updating player: %w", err)
-	}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("error getting rows affected: %w", err)
+		}
 
-	rowsAffected, err := result.RowsAffected()
+		if rowsAffected == 0 {
+			return domain.NewNotFoundError("player not found")
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("error getting rows affected: %w", err)
-	}
-
-	if rowsAffected == 0 {
-		return nil, domain.NewNotFoundError("player not found")
-	}
-
-	if err = tx.Commit(); err != nil {
-		return nil, fmt.Errorf("error committing transaction: %w", err)
+		return nil, err
 	}
 
 	return player, nil
@@ -8,8 +8,14 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"time"
+
+	"github.com/lib/pq"
 )
 
+// qualifyingWindowWatermark is the name of the watermark of the qualifying window watcher
+const qualifyingWindowWatermark = "qualifying_windows"
+
 type QualifyingRepository struct {
 	db *sql.DB
 }
@@ -27,12 +33,19 @@ func (r *QualifyingRepository) FindByTournamentId(ctx context.Context, id string
 	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
 	defer cancel()
 
+	// The attempts of a player are combined into their qualifying time according to the qualifying mode of the tournament
 	query := `
-			SELECT q.player_id, p.name, RANK() OVER (ORDER BY q.time) as position,  q.created_at, q.time
-			FROM qualifying q JOIN players p ON q.player_id = p.id
+			SELECT q.player_id, p.name, q.created_at, q.time, t.qualifying_mode,
+			       COALESCE(ARRAY_AGG(a.time ORDER BY a.created_at) FILTER (WHERE a.id IS NOT NULL), '{}')
+			FROM qualifying q
+			JOIN players p ON q.player_id = p.id
+			JOIN tournaments t ON q.tournament_id = t.id
+			LEFT JOIN qualifying_attempts a ON a.tournament_id = q.tournament_id AND a.player_id = q.player_id
 			WHERE q.tournament_id = $1
+			GROUP BY q.player_id, p.name, q.created_at, q.time, t.qualifying_mode
+			ORDER BY q.created_at
 		`
-	rows, err := r.db.QueryContext(ctx, query, id)
+	rows, err := connFor(ctx, r.db).QueryContext(ctx, query, id)
 	if err != nil {
 		return nil, fmt.Errorf("error querying tournaments: %w", err)
 	}
@@ -41,16 +54,28 @@ func (r *QualifyingRepository) FindByTournamentId(ctx context.Context, id string
 	players := make([]*domain.QualifyingPlayer, 0)
 	for rows.Next() {
 		player := new(domain.QualifyingPlayer)
+		config := domain.QualifyingConfig{}
+		var attempts pq.Int64Array
 		err := rows.Scan(
 			&player.PlayerId,
 			&player.Name,
-			&player.Position,
 			&player.SignupDate,
 			&player.Time,
+			&config.Mode,
+			&attempts,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("error scanning tournament: %w", err)
 		}
+
+		times := make([]int, 0, len(attempts))
+		for _, attempt := range attempts {
+			times = append(times, int(attempt))
+		}
+		if len(times) > 0 {
+			player.Time = config.QualifyingTime(times)
+		}
+		player.Attempts = len(times)
 		players = append(players, player)
 	}
 
@@ -62,6 +87,7 @@ func (r *QualifyingRepository) FindByTournamentId(ctx context.Context, id string
 		TournamentId: id,
 		Players:      players,
 	}
+	qualifying.RankPlayers()
 
 	return &qualifying, nil
 }
@@ -71,7 +97,7 @@ func (r *QualifyingRepository) DeleteByTournamentId(ctx context.Context, id stri
 	defer cancel()
 
 	query := `DELETE FROM qualifying WHERE tournament_id = $1`
-	result, err := r.db.ExecContext(ctx, query, id)
+	result, err := connFor(ctx, r.db).ExecContext(ctx, query, id)
 	if err != nil {
 		return fmt.Errorf("error deleting tournament: %w", err)
 	}
@@ -84,7 +110,7 @@ func (r *QualifyingRepository) AddPlayer(ctx context.Context, tournamentId strin
 	defer cancel()
 
 	query := `INSERT INTO qualifying (tournament_id, player_id) VALUES ($1, $2)`
-	result, err := r.db.ExecContext(ctx, query, tournamentId, playerId)
+	result, err := connFor(ctx, r.db).ExecContext(ctx, query, tournamentId, playerId)
 
 	if err != nil {
 		return fmt.Errorf("error adding player to qualifying: %w", err)
@@ -93,6 +119,155 @@ func (r *QualifyingRepository) AddPlayer(ctx context.Context, tournamentId strin
 	return r.checkRowsAffected(result, "error adding player to qualifying")
 }
 
+// AddAttempt stores a qualifying attempt of a player who takes part in the qualifying
+func (r *QualifyingRepository) AddAttempt(ctx context.Context, attempt *domain.QualifyingAttempt) (*domain.QualifyingAttempt, error) {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	err := inTransaction(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			INSERT INTO qualifying_attempts (tournament_id, player_id, time)
+			VALUES ($1, $2, $3)
+			RETURNING id, created_at
+		`
+		err := tx.QueryRowContext(ctx, query, attempt.TournamentId, attempt.PlayerId, attempt.Time).Scan(&attempt.Id, &attempt.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("error saving qualifying attempt: %w", err)
+		}
+
+		query = `
+			UPDATE qualifying
+			SET updated_at = NOW()
+			WHERE tournament_id = $1 AND player_id = $2
+		`
+		result, err := tx.ExecContext(ctx, query, attempt.TournamentId, attempt.PlayerId)
+		if err != nil {
+			return fmt.Errorf("error saving qualifying attempt: %w", err)
+		}
+
+		return r.checkRowsAffected(result, "player not found in qualifying")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return attempt, nil
+}
+
+// FindAttempts returns all qualifying attempts of a player in submission order
+func (r *QualifyingRepository) FindAttempts(ctx context.Context, tournamentId string, playerId string) ([]*domain.QualifyingAttempt, error) {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	query := `
+		SELECT id, tournament_id, player_id, time, created_at
+		FROM qualifying_attempts
+		WHERE tournament_id = $1 AND player_id = $2
+		ORDER BY created_at
+	`
+	rows, err := connFor(ctx, r.db).QueryContext(ctx, query, tournamentId, playerId)
+	if err != nil {
+		return nil, fmt.Errorf("error querying qualifying attempts: %w", err)
+	}
+	defer r.closeRows(rows)
+
+	attempts := make([]*domain.QualifyingAttempt, 0)
+	for rows.Next() {
+		attempt := new(domain.QualifyingAttempt)
+		err := rows.Scan(&attempt.Id, &attempt.TournamentId, &attempt.PlayerId, &attempt.Time, &attempt.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning qualifying attempt: %w", err)
+		}
+		attempts = append(attempts, attempt)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating qualifying attempts: %w", err)
+	}
+
+	return attempts, nil
+}
+
+// FindWindowChanges returns the ids of all draft tournaments whose qualifying window opened or closed
+// by schedule in the time range (from, to]
+func (r *QualifyingRepository) FindWindowChanges(ctx context.Context, from time.Time, to time.Time) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	query := `
+		SELECT id
+		FROM tournaments
+		WHERE status = 'DRAFT'
+		  AND qualifying_closed_at IS NULL
+		  AND (
+		      (qualifying_opens_at > $1 AND qualifying_opens_at <= $2 AND qualifying_opened_at IS NULL)
+		      OR (qualifying_closes_at > $1 AND qualifying_closes_at <= $2)
+		  )
+	`
+	rows, err := connFor(ctx, r.db).QueryContext(ctx, query, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("error querying qualifying windows: %w", err)
+	}
+	defer r.closeRows(rows)
+
+	tournamentIds := make([]string, 0)
+	for rows.Next() {
+		var tournamentId string
+		if err := rows.Scan(&tournamentId); err != nil {
+			return nil, fmt.Errorf("error scanning qualifying window: %w", err)
+		}
+		tournamentIds = append(tournamentIds, tournamentId)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating qualifying windows: %w", err)
+	}
+
+	return tournamentIds, nil
+}
+
+// LockWindowWatermark returns the time up to which qualifying window changes have been announced and locks it
+// until the running transaction ends. The given time is stored if no watermark exists yet.
+func (r *QualifyingRepository) LockWindowWatermark(ctx context.Context, initial time.Time) (time.Time, error) {
+	if _, ok := ctx.Value(txKey{}).(*sql.Tx); !ok {
+		return time.Time{}, errors.New("locking the qualifying window watermark requires a transaction")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	query := `
+		INSERT INTO worker_watermarks (name, watched_until)
+		VALUES ($1, $2)
+		ON CONFLICT (name) DO NOTHING
+	`
+	if _, err := connFor(ctx, r.db).ExecContext(ctx, query, qualifyingWindowWatermark, initial); err != nil {
+		return time.Time{}, fmt.Errorf("error creating qualifying window watermark: %w", err)
+	}
+
+	var watchedUntil time.Time
+	query = `SELECT watched_until FROM worker_watermarks WHERE name = $1 FOR UPDATE`
+	if err := connFor(ctx, r.db).QueryRowContext(ctx, query, qualifyingWindowWatermark).Scan(&watchedUntil); err != nil {
+		return time.Time{}, fmt.Errorf("error locking qualifying window watermark: %w", err)
+	}
+
+	return watchedUntil, nil
+}
+
+// SaveWindowWatermark stores the time up to which qualifying window changes have been announced
+func (r *QualifyingRepository) SaveWindowWatermark(ctx context.Context, watchedUntil time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	query := `UPDATE worker_watermarks SET watched_until = $2 WHERE name = $1`
+	result, err := connFor(ctx, r.db).ExecContext(ctx, query, qualifyingWindowWatermark, watchedUntil)
+	if err != nil {
+		return fmt.Errorf("error saving qualifying window watermark: %w", err)
+	}
+
+	return r.checkRowsAffected(result, "qualifying window watermark not found")
+}
+
 func (r *QualifyingRepository) checkRowsAffected(result sql.Result, notFoundMsg string) error {
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
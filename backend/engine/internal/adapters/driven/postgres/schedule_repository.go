@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"engine/internal/domain"
+	"engine/internal/ports/output"
+	"errors"
+	"fmt"
+	"log"
+)
+
+type ScheduleRepository struct {
+	db *sql.DB
+}
+
+// NewScheduleRepository creates a new PostgreSQL schedule repository
+func NewScheduleRepository(db *sql.DB) (output.ScheduleRepositoryInterface, error) {
+	if db == nil {
+		return nil, errors.New("db cannot be nil")
+	}
+	return &ScheduleRepository{
+		db: db,
+	}, nil
+}
+
+// FindDelays returns all delays of a tournament in the order they were added
+func (r *ScheduleRepository) FindDelays(ctx context.Context, tournamentId string) ([]domain.ScheduleDelay, error) {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	query := `
+		SELECT id, round_id, slot, minutes, COALESCE(reason, ''), created_at
+		FROM schedule_delays
+		WHERE tournament_id = $1
+		ORDER BY created_at
+	`
+	rows, err := connFor(ctx, r.db).QueryContext(ctx, query, tournamentId)
+	if err != nil {
+		return nil, fmt.Errorf("error querying schedule delays: %w", err)
+	}
+	defer r.closeRows(rows)
+
+	delays := make([]domain.ScheduleDelay, 0)
+	for rows.Next() {
+		delay := domain.ScheduleDelay{}
+		err := rows.Scan(&delay.Id, &delay.RoundId, &delay.Slot, &delay.Minutes, &delay.Reason, &delay.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning schedule delay: %w", err)
+		}
+		delays = append(delays, delay)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating schedule delays: %w", err)
+	}
+
+	return delays, nil
+}
+
+// AddDelay stores a delay of a slot
+func (r *ScheduleRepository) AddDelay(ctx context.Context, tournamentId string, delay *domain.ScheduleDelay) (*domain.ScheduleDelay, error) {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	query := `
+		INSERT INTO schedule_delays (tournament_id, round_id, slot, minutes, reason)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''))
+		RETURNING id, created_at
+	`
+	err := connFor(ctx, r.db).QueryRowContext(ctx, query, tournamentId, delay.RoundId, delay.Slot, delay.Minutes, delay.Reason).Scan(&delay.Id, &delay.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("error saving schedule delay: %w", err)
+	}
+
+	return delay, nil
+}
+
+func (r *ScheduleRepository) closeRows(rows *sql.Rows) {
+	if err := rows.Close(); err != nil {
+		log.Printf("failed to close rows: %v", err)
+	}
+}
@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"engine/internal/domain"
+	"engine/internal/ports/output"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/lib/pq"
+)
+
+type StationRepository struct {
+	db *sql.DB
+}
+
+// NewStationRepository creates a new PostgreSQL station repository
+func NewStationRepository(db *sql.DB) (output.StationRepositoryInterface, error) {
+	if db == nil {
+		return nil, errors.New("db cannot be nil")
+	}
+	return &StationRepository{
+		db: db,
+	}, nil
+}
+
+// FindByTournamentId returns all stations of a tournament ordered by number
+func (r *StationRepository) FindByTournamentId(ctx context.Context, tournamentId string) ([]domain.Station, error) {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	query := `
+		SELECT id, tournament_id, number, COALESCE(name, ''), COALESCE(group_id::text, ''), assigned_at
+		FROM stations
+		WHERE tournament_id = $1
+		ORDER BY number
+	`
+	rows, err := connFor(ctx, r.db).QueryContext(ctx, query, tournamentId)
+	if err != nil {
+		return nil, fmt.Errorf("error querying stations: %w", err)
+	}
+	defer r.closeRows(rows)
+
+	stations := make([]domain.Station, 0)
+	for rows.Next() {
+		station := domain.Station{}
+		err := rows.Scan(&station.Id, &station.TournamentId, &station.Number, &station.Name, &station.GroupId, &station.AssignedAt)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning station: %w", err)
+		}
+		stations = append(stations, station)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating stations: %w", err)
+	}
+
+	return stations, nil
+}
+
+// Insert stores a new station. A station without a number receives the next free number.
+func (r *StationRepository) Insert(ctx context.Context, station *domain.Station) (*domain.Station, error) {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	query := `
+		INSERT INTO stations (tournament_id, number, name)
+		SELECT $1, COALESCE(NULLIF($2, 0), MAX(number) + 1, 1), NULLIF($3, '')
+		FROM stations
+		WHERE tournament_id = $1
+		RETURNING id, number
+	`
+	err := connFor(ctx, r.db).QueryRowContext(ctx, query, station.TournamentId, station.Number, station.Name).Scan(&station.Id, &station.Number)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return nil, domain.NewConflictError(fmt.Sprintf("station %d already exists", station.Number))
+		}
+		return nil, fmt.Errorf("error saving station: %w", err)
+	}
+
+	return station, nil
+}
+
+// Delete removes a station of a tournament
+func (r *StationRepository) Delete(ctx context.Context, tournamentId string, stationId string) error {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	result, err := connFor(ctx, r.db).ExecContext(ctx, `DELETE FROM stations WHERE tournament_id = $1 AND id = $2`, tournamentId, stationId)
+	if err != nil {
+		return fmt.Errorf("error deleting station: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error deleting station: %w", err)
+	}
+	if rows == 0 {
+		return domain.NewNotFoundError("station not found")
+	}
+	return nil
+}
+
+// SaveAssignments stores the groups currently playing on the given stations
+func (r *StationRepository) SaveAssignments(ctx context.Context, stations []domain.Station) error {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	return inTransaction(ctx, r.db, func(tx *sql.Tx) error {
+		for _, station := range stations {
+			query := `
+				UPDATE stations
+				SET group_id = NULLIF($2, '')::uuid, assigned_at = $3
+				WHERE id = $1
+			`
+			_, err := tx.ExecContext(ctx, query, station.Id, station.GroupId, station.AssignedAt)
+			if err != nil {
+				return fmt.Errorf("error assigning station: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *StationRepository) closeRows(rows *sql.Rows) {
+	if err := rows.Close(); err != nil {
+		log.Printf("failed to close rows: %v", err)
+	}
+}
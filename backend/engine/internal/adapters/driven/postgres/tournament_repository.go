@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"engine/internal/domain"
 	"engine/internal/ports/output"
 	"errors"
@@ -10,6 +11,8 @@ import (
 	"log"
 	"strings"
 	"time"
+
+	"github.com/lib/pq"
 )
 
 const (
@@ -43,6 +46,28 @@ func (r *TournamentRepository) FindByID(ctx context.Context, id string) (*domain
 	return r.populateTournamentDetails(ctx, tournament)
 }
 
+// FindByIDForUpdate retrieves a tournament by its Id and locks its row until the running transaction ends,
+// so concurrent changes of the same tournament are applied one after another
+func (r *TournamentRepository) FindByIDForUpdate(ctx context.Context, id string) (*domain.Tournament, error) {
+	if _, ok := ctx.Value(txKey{}).(*sql.Tx); !ok {
+		return nil, errors.New("locking a tournament requires a transaction")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	var lockedID string
+	err := connFor(ctx, r.db).QueryRowContext(ctx, `SELECT id FROM tournaments WHERE id = $1 FOR UPDATE`, id).Scan(&lockedID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.NewNotFoundError("tournament not found")
+		}
+		return nil, fmt.Errorf("error locking tournament: %w", err)
+	}
+
+	return r.FindByID(ctx, id)
+}
+
 // FindAll retrieves all tournaments
 func (r *TournamentRepository) FindAll(ctx context.Context) ([]*domain.IndexTournament, error) {
 	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
@@ -52,7 +77,7 @@ func (r *TournamentRepository) FindAll(ctx context.Context) ([]*domain.IndexTour
 		SELECT id, name, description, start_date, end_date, status, player_count
 		FROM tournaments
 	`
-	rows, err := r.db.QueryContext(ctx, query)
+	rows, err := connFor(ctx, r.db).QueryContext(ctx, query)
 	if err != nil {
 		return nil, fmt.Errorf("error querying tournaments: %w", err)
 	}
@@ -86,7 +111,7 @@ func (r *TournamentRepository) Delete(ctx context.Context, id string) error {
 	defer cancel()
 
 	query := `DELETE FROM tournaments WHERE id = $1`
-	result, err := r.db.ExecContext(ctx, query, id)
+	result, err := connFor(ctx, r.db).ExecContext(ctx, query, id)
 	if err != nil {
 		return fmt.Errorf("error deleting tournament: %w", err)
 	}
@@ -95,6 +120,28 @@ func (r *TournamentRepository) Delete(ctx context.Context, id string) error {
 }
 
 func (r *TournamentRepository) Update(ctx context.Context, tournament *domain.Tournament) (*domain.Tournament, error) {
+	return r.executeInTransaction(ctx, func(ctx context.Context, tx *sql.Tx) (*domain.Tournament, error) {
+		query := `
+			UPDATE tournaments
+			SET status = $1, paused_by = NULLIF($2, ''), pause_reason = NULLIF($3, ''), paused_at = $4
+			WHERE id = $5
+		`
+		result, err := tx.ExecContext(ctx, query, tournament.Status, tournament.PausedBy, tournament.PauseReason, tournament.PausedAt, tournament.Id)
+		if err != nil {
+			return nil, fmt.Errorf("error updating tournament: %w", err)
+		}
+
+		err = r.checkRowsAffected(result, "tournament not found")
+		if err != nil {
+			return nil, err
+		}
+
+		return tournament, nil
+	})
+}
+
+// StartRound updates the tournament status and persists the drawn groups of the given round in a single transaction
+func (r *TournamentRepository) StartRound(ctx context.Context, tournament *domain.Tournament, round *domain.Round) (*domain.Tournament, error) {
 	return r.executeInTransaction(ctx, func(ctx context.Context, tx *sql.Tx) (*domain.Tournament, error) {
 		query := `
 			UPDATE tournaments
@@ -111,35 +158,92 @@ func (r *TournamentRepository) Update(ctx context.Context, tournament *domain.To
 			return nil, err
 		}
 
+		for i := range round.Groups {
+			err = r.insertGroup(ctx, tx, &round.Groups[i], round.Id)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		return tournament, nil
 	})
 }
 
-// Helper methods
+// UpdateQualifyingWindow persists the time when qualifying was opened manually
+func (r *TournamentRepository) UpdateQualifyingWindow(ctx context.Context, tournament *domain.Tournament) (*domain.Tournament, error) {
+	return r.executeInTransaction(ctx, func(ctx context.Context, tx *sql.Tx) (*domain.Tournament, error) {
+		query := `
+			UPDATE tournaments
+			SET qualifying_opened_at = $1
+			WHERE id = $2
+		`
+		result, err := tx.ExecContext(ctx, query, tournament.Qualifying.OpenedAt, tournament.Id)
+		if err != nil {
+			return nil, fmt.Errorf("error updating tournament: %w", err)
+		}
 
-func (r *TournamentRepository) executeInTransaction(ctx context.Context, fn func(context.Context, *sql.Tx) (*domain.Tournament, error)) (*domain.Tournament, error) {
-	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
-	if err != nil {
-		return nil, fmt.Errorf("error starting transaction: %w", err)
-	}
+		err = r.checkRowsAffected(result, "tournament not found")
+		if err != nil {
+			return nil, err
+		}
+
+		return tournament, nil
+	})
+}
+
+// UpdateQualifyingResult persists the closing time of the qualifying and the status of every player in a single transaction
+func (r *TournamentRepository) UpdateQualifyingResult(ctx context.Context, tournament *domain.Tournament) (*domain.Tournament, error) {
+	return r.executeInTransaction(ctx, func(ctx context.Context, tx *sql.Tx) (*domain.Tournament, error) {
+		query := `
+			UPDATE tournaments
+			SET qualifying_closed_at = $1
+			WHERE id = $2
+		`
+		result, err := tx.ExecContext(ctx, query, tournament.Qualifying.ClosedAt, tournament.Id)
+		if err != nil {
+			return nil, fmt.Errorf("error updating tournament: %w", err)
+		}
 
-	defer func() {
+		err = r.checkRowsAffected(result, "tournament not found")
 		if err != nil {
-			if rollbackErr := tx.Rollback(); rollbackErr != nil {
-				err = errors.Join(err, fmt.Errorf("rollback failed: %w", rollbackErr))
+			return nil, err
+		}
+
+		query = `
+			UPDATE players
+			SET status = $1
+			WHERE id = $2 AND tournament_id = $3
+		`
+		for _, player := range tournament.Players {
+			_, err = tx.ExecContext(ctx, query, player.Status, player.Id, tournament.Id)
+			if err != nil {
+				return nil, fmt.Errorf("error updating player status: %w", err)
 			}
 		}
-	}()
 
-	result, err := fn(ctx, tx)
+		return tournament, nil
+	})
+}
+
+// Helper methods
+
+func (r *TournamentRepository) executeInTransaction(ctx context.Context, fn func(context.Context, *sql.Tx) (*domain.Tournament, error)) (*domain.Tournament, error) {
+	var result *domain.Tournament
+	err := inTransaction(ctx, r.db, func(tx *sql.Tx) error {
+		var err error
+		result, err = fn(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		// Recorded events are stored with the change, so they are published even if the process stops right after the commit
+		return insertOutboxEvents(ctx, tx, result)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err = tx.Commit(); err != nil {
-		return nil, fmt.Errorf("error committing transaction: %w", err)
-	}
-
+	result.Events = nil
 	return result, nil
 }
 
@@ -154,6 +258,15 @@ func (r *TournamentRepository) populateTournamentDetails(ctx context.Context, to
 	if err != nil {
 		return nil, err
 	}
+
+	groups, err := r.findGroupsByTournamentID(ctx, tournament.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range rounds {
+		rounds[i].Groups = append(rounds[i].Groups, groups[rounds[i].Id]...)
+	}
 	tournament.Rounds = rounds
 
 	return tournament, nil
@@ -204,12 +317,16 @@ func (r *TournamentRepository) closeRows(rows *sql.Rows) {
 
 func (r *TournamentRepository) findTournamentByID(ctx context.Context, id string) (*domain.Tournament, error) {
 	query := `
-		SELECT id, name, description, start_date, end_date, status, player_count
+		SELECT id, name, description, start_date, end_date, status, player_count, allow_underfilled_groups, seeding_strategy, seeding_seed, points_scheme, map_pool,
+		       qualifying_attempt_limit, qualifying_mode, qualifying_opens_at, qualifying_closes_at, qualifying_opened_at, qualifying_closed_at,
+		       schedule_starts_at, match_duration_minutes, station_count,
+		       COALESCE(paused_by, ''), COALESCE(pause_reason, ''), paused_at
 		FROM tournaments
 		WHERE id = $1
 	`
-	row := r.db.QueryRowContext(ctx, query, id)
+	row := connFor(ctx, r.db).QueryRowContext(ctx, query, id)
 	tournament := new(domain.Tournament)
+	var pointsScheme, mapPool []byte
 	err := row.Scan(
 		&tournament.Id,
 		&tournament.Name,
@@ -218,6 +335,23 @@ func (r *TournamentRepository) findTournamentByID(ctx context.Context, id string
 		&tournament.EndDate,
 		&tournament.Status,
 		&tournament.PlayerCount,
+		&tournament.AllowUnderfilledGroups,
+		&tournament.SeedingStrategy,
+		&tournament.SeedingSeed,
+		&pointsScheme,
+		&mapPool,
+		&tournament.Qualifying.AttemptLimit,
+		&tournament.Qualifying.Mode,
+		&tournament.Qualifying.OpensAt,
+		&tournament.Qualifying.ClosesAt,
+		&tournament.Qualifying.OpenedAt,
+		&tournament.Qualifying.ClosedAt,
+		&tournament.Schedule.StartsAt,
+		&tournament.Schedule.MatchDurationMinutes,
+		&tournament.Schedule.StationCount,
+		&tournament.PausedBy,
+		&tournament.PauseReason,
+		&tournament.PausedAt,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -228,16 +362,26 @@ func (r *TournamentRepository) findTournamentByID(ctx context.Context, id string
 		}
 		return nil, fmt.Errorf("error finding tournament: %w", err)
 	}
+	if err = json.Unmarshal(pointsScheme, &tournament.PointsScheme); err != nil {
+		return nil, fmt.Errorf("error decoding points scheme: %w", err)
+	}
+	if mapPool != nil {
+		tournament.MapPool = new(domain.MapPool)
+		if err = json.Unmarshal(mapPool, tournament.MapPool); err != nil {
+			return nil, fmt.Errorf("error decoding map pool: %w", err)
+		}
+	}
 	return tournament, nil
 }
 
 func (r *TournamentRepository) findPlayersByTournamentID(ctx context.Context, tournamentID string) ([]domain.Player, error) {
 	query := `
-		SELECT id, name, tournament_id
+		SELECT id, name, tournament_id, status
 		FROM players
 		WHERE tournament_id = $1
+		ORDER BY created_at
 	`
-	rows, err := r.db.QueryContext(ctx, query, tournamentID)
+	rows, err := connFor(ctx, r.db).QueryContext(ctx, query, tournamentID)
 	if err != nil {
 		return nil, fmt.Errorf("error querying players: %w", err)
 	}
@@ -246,7 +390,7 @@ func (r *TournamentRepository) findPlayersByTournamentID(ctx context.Context, to
 	var players []domain.Player
 	for rows.Next() {
 		player := domain.Player{}
-		err := rows.Scan(&player.Id, &player.Name, &player.TournamentId)
+		err := rows.Scan(&player.Id, &player.Name, &player.TournamentId, &player.Status)
 		if err != nil {
 			return nil, fmt.Errorf("error scanning player: %w", err)
 		}
@@ -260,10 +404,12 @@ func (r *TournamentRepository) findPlayersByTournamentID(ctx context.Context, to
 
 func (r *TournamentRepository) findRoundsByTournamentID(ctx context.Context, tournamentID string) ([]domain.Round, error) {
 	query := `
-		SELECT id, name, match_count, player_count, player_advancement_count, group_size, concurrent_group_count
+		SELECT id, name, position, type, match_count, player_count, player_advancement_count, lucky_loser_count, group_size, concurrent_group_count, points_scheme, map_pool, tie_breakers, tie_break_seed, grand_final_reset, round_robin_cycles
 		FROM rounds
+		WHERE tournament_id = $1
+		ORDER BY position
 	`
-	rows, err := r.db.QueryContext(ctx, query)
+	rows, err := connFor(ctx, r.db).QueryContext(ctx, query, tournamentID)
 	if err != nil {
 		return nil, fmt.Errorf("error querying rounds: %w", err)
 	}
@@ -272,25 +418,243 @@ func (r *TournamentRepository) findRoundsByTournamentID(ctx context.Context, tou
 	var rounds []domain.Round
 	for rows.Next() {
 		round := domain.Round{}
-		err := rows.Scan(&round.Id, &round.Name, &round.MatchCount, &round.PlayerCount, &round.PlayerAdvancementCount, &round.GroupSize, &round.ConcurrentGroupCount)
+		var pointsScheme, mapPool, tieBreakers []byte
+		err := rows.Scan(&round.Id, &round.Name, &round.Position, &round.Type, &round.MatchCount, &round.PlayerCount, &round.PlayerAdvancementCount, &round.LuckyLoserCount, &round.GroupSize, &round.ConcurrentGroupCount, &pointsScheme, &mapPool, &tieBreakers, &round.TieBreakSeed, &round.GrandFinalReset, &round.RoundRobinCycles)
 		if err != nil {
 			return nil, fmt.Errorf("error scanning round: %w", err)
 		}
+		if pointsScheme != nil {
+			round.PointsScheme = new(domain.PointsScheme)
+			if err = json.Unmarshal(pointsScheme, round.PointsScheme); err != nil {
+				return nil, fmt.Errorf("error decoding round points scheme: %w", err)
+			}
+		}
+		if mapPool != nil {
+			round.MapPool = new(domain.MapPool)
+			if err = json.Unmarshal(mapPool, round.MapPool); err != nil {
+				return nil, fmt.Errorf("error decoding round map pool: %w", err)
+			}
+		}
+		if tieBreakers != nil {
+			if err = json.Unmarshal(tieBreakers, &round.TieBreakers); err != nil {
+				return nil, fmt.Errorf("error decoding round tie-breakers: %w", err)
+			}
+		}
 		round.TournamentId = tournamentID
 		round.Groups = make([]domain.Group, 0)
 		rounds = append(rounds, round)
 	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rounds: %w", err)
+	}
 	return rounds, nil
 }
 
+// findGroupsByTournamentID loads all groups of a tournament including their players, matches and placements, keyed by round id
+func (r *TournamentRepository) findGroupsByTournamentID(ctx context.Context, tournamentID string) (map[string][]domain.Group, error) {
+	players, err := r.findGroupPlayersByTournamentID(ctx, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := r.findMatchesByTournamentID(ctx, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT g.id, COALESCE(g.name, ''), g.round_id, g.position
+		FROM groups g
+		JOIN rounds r ON g.round_id = r.id
+		WHERE r.tournament_id = $1
+		ORDER BY g.position
+	`
+	rows, err := connFor(ctx, r.db).QueryContext(ctx, query, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("error querying groups: %w", err)
+	}
+	defer r.closeRows(rows)
+
+	groups := make(map[string][]domain.Group)
+	for rows.Next() {
+		group := domain.Group{}
+		err := rows.Scan(&group.Id, &group.Name, &group.RoundId, &group.Position)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning group: %w", err)
+		}
+		group.Players = append(make([]domain.Player, 0), players[group.Id]...)
+		group.Matches = append(make([]domain.Match, 0), matches[group.Id]...)
+		groups[group.RoundId] = append(groups[group.RoundId], group)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating groups: %w", err)
+	}
+	return groups, nil
+}
+
+// findGroupPlayersByTournamentID loads the players assigned to groups of a tournament, keyed by group id
+func (r *TournamentRepository) findGroupPlayersByTournamentID(ctx context.Context, tournamentID string) (map[string][]domain.Player, error) {
+	query := `
+		SELECT pg.group_id, p.id, p.name, p.tournament_id
+		FROM player_groups pg
+		JOIN players p ON pg.player_id = p.id
+		WHERE p.tournament_id = $1
+		ORDER BY pg.seed, p.created_at
+	`
+	rows, err := connFor(ctx, r.db).QueryContext(ctx, query, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("error querying group players: %w", err)
+	}
+	defer r.closeRows(rows)
+
+	players := make(map[string][]domain.Player)
+	for rows.Next() {
+		var groupID string
+		player := domain.Player{}
+		err := rows.Scan(&groupID, &player.Id, &player.Name, &player.TournamentId)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning group player: %w", err)
+		}
+		players[groupID] = append(players[groupID], player)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating group players: %w", err)
+	}
+	return players, nil
+}
+
+// findMatchesByTournamentID loads the matches of a tournament including their placements, keyed by group id
+func (r *TournamentRepository) findMatchesByTournamentID(ctx context.Context, tournamentID string) (map[string][]domain.Match, error) {
+	placements, err := r.findPlacementsByTournamentID(ctx, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	players, err := r.findMatchPlayersByTournamentID(ctx, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT m.id, m.group_id, m.position, m.stage, COALESCE(m.bracket, ''), COALESCE(m.map_name, ''), COALESCE(m.map_picker_id::text, ''),
+		       m.next_match_position, m.loser_next_match_position
+		FROM matches m
+		JOIN groups g ON m.group_id = g.id
+		JOIN rounds r ON g.round_id = r.id
+		WHERE r.tournament_id = $1
+		ORDER BY m.position
+	`
+	rows, err := connFor(ctx, r.db).QueryContext(ctx, query, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("error querying matches: %w", err)
+	}
+	defer r.closeRows(rows)
+
+	matches := make(map[string][]domain.Match)
+	for rows.Next() {
+		match := domain.Match{}
+		err := rows.Scan(
+			&match.Id,
+			&match.GroupId,
+			&match.Position,
+			&match.Stage,
+			&match.Bracket,
+			&match.MapName,
+			&match.MapPickerId,
+			&match.NextMatchPosition,
+			&match.LoserNextMatchPosition,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning match: %w", err)
+		}
+		match.Players = players[match.Id]
+		match.Placements = append(make([]domain.Placement, 0), placements[match.Id]...)
+		matches[match.GroupId] = append(matches[match.GroupId], match)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating matches: %w", err)
+	}
+	return matches, nil
+}
+
+// findMatchPlayersByTournamentID loads the explicit participants of the matches of a tournament, keyed by match id
+func (r *TournamentRepository) findMatchPlayersByTournamentID(ctx context.Context, tournamentID string) (map[string][]domain.Player, error) {
+	query := `
+		SELECT mp.match_id, p.id, p.name, p.tournament_id, p.status
+		FROM match_players mp
+		JOIN players p ON mp.player_id = p.id
+		WHERE p.tournament_id = $1
+		ORDER BY mp.seed
+	`
+	rows, err := connFor(ctx, r.db).QueryContext(ctx, query, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("error querying match players: %w", err)
+	}
+	defer r.closeRows(rows)
+
+	players := make(map[string][]domain.Player)
+	for rows.Next() {
+		var matchID string
+		player := domain.Player{}
+		err := rows.Scan(&matchID, &player.Id, &player.Name, &player.TournamentId, &player.Status)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning match player: %w", err)
+		}
+		players[matchID] = append(players[matchID], player)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating match players: %w", err)
+	}
+	return players, nil
+}
+
+// findPlacementsByTournamentID loads the placements of a tournament, keyed by match id
+func (r *TournamentRepository) findPlacementsByTournamentID(ctx context.Context, tournamentID string) (map[string][]domain.Placement, error) {
+	query := `
+		SELECT pl.id, pl.match_id, pl.player_id, pl.placement
+		FROM placements pl
+		JOIN players p ON pl.player_id = p.id
+		WHERE p.tournament_id = $1
+		ORDER BY pl.placement
+	`
+	rows, err := connFor(ctx, r.db).QueryContext(ctx, query, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("error querying placements: %w", err)
+	}
+	defer r.closeRows(rows)
+
+	placements := make(map[string][]domain.Placement)
+	for rows.Next() {
+		placement := domain.Placement{}
+		err := rows.Scan(&placement.Id, &placement.MatchId, &placement.PlayerId, &placement.Placement)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning placement: %w", err)
+		}
+		placements[placement.MatchId] = append(placements[placement.MatchId], placement)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating placements: %w", err)
+	}
+	return placements, nil
+}
+
 func (r *TournamentRepository) insertTournament(ctx context.Context, tx *sql.Tx, tournament *domain.Tournament) (string, error) {
 	var tournamentID string
 	query := `
-        INSERT INTO tournaments (name, description, start_date, end_date, status, player_count, allow_underfilled_groups)
-        VALUES ($1, $2, $3, $4, $5, $6, $7)
+        INSERT INTO tournaments (name, description, start_date, end_date, status, player_count, allow_underfilled_groups, seeding_strategy, seeding_seed, points_scheme, map_pool, qualifying_attempt_limit, qualifying_mode,
+                                 qualifying_opens_at, qualifying_closes_at, schedule_starts_at, match_duration_minutes, station_count)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
         RETURNING id
     `
-	err := tx.QueryRowContext(
+	pointsScheme, err := json.Marshal(tournament.PointsScheme)
+	if err != nil {
+		return "", fmt.Errorf("error encoding points scheme: %w", err)
+	}
+	mapPool, err := marshalNullableJSON(tournament.MapPool)
+	if err != nil {
+		return "", fmt.Errorf("error encoding map pool: %w", err)
+	}
+	err = tx.QueryRowContext(
 		ctx,
 		query,
 		tournament.Name,
@@ -300,6 +664,17 @@ func (r *TournamentRepository) insertTournament(ctx context.Context, tx *sql.Tx,
 		tournament.Status,
 		tournament.PlayerCount,
 		tournament.AllowUnderfilledGroups,
+		tournament.SeedingStrategy,
+		tournament.SeedingSeed,
+		string(pointsScheme),
+		mapPool,
+		tournament.Qualifying.AttemptLimit,
+		tournament.Qualifying.Mode,
+		tournament.Qualifying.OpensAt,
+		tournament.Qualifying.ClosesAt,
+		tournament.Schedule.StartsAt,
+		tournament.Schedule.MatchDurationMinutes,
+		tournament.Schedule.StationCount,
 	).Scan(&tournamentID)
 	if err != nil {
 		return "", fmt.Errorf("error saving tournament: %w", err)
@@ -308,28 +683,105 @@ func (r *TournamentRepository) insertTournament(ctx context.Context, tx *sql.Tx,
 }
 
 func (r *TournamentRepository) insertRounds(ctx context.Context, tx *sql.Tx, rounds []domain.Round, tournamentID string) error {
+	columns := []string{"name", "tournament_id", "position", "type", "match_count", "player_count", "player_advancement_count", "lucky_loser_count", "group_size", "concurrent_group_count", "points_scheme", "map_pool", "tie_breakers", "tie_break_seed", "grand_final_reset", "round_robin_cycles"}
+
 	placeholders := make([]string, len(rounds))
-	args := make([]interface{}, 0, len(rounds)*7)
+	args := make([]interface{}, 0, len(rounds)*len(columns))
 	for i, round := range rounds {
-		start := i*7 + 1
-		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d)",
-			start, start+1, start+2, start+3, start+4, start+5, start+6)
+		pointsScheme, err := marshalNullableJSON(round.PointsScheme)
+		if err != nil {
+			return fmt.Errorf("error encoding points scheme: %w", err)
+		}
+		mapPool, err := marshalNullableJSON(round.MapPool)
+		if err != nil {
+			return fmt.Errorf("error encoding map pool: %w", err)
+		}
+
+		var tieBreakers *[]domain.TieBreaker
+		if len(round.TieBreakers) > 0 {
+			tieBreakers = &round.TieBreakers
+		}
+		encodedTieBreakers, err := marshalNullableJSON(tieBreakers)
+		if err != nil {
+			return fmt.Errorf("error encoding tie-breakers: %w", err)
+		}
+
+		rowPlaceholders := make([]string, len(columns))
+		for j := range columns {
+			rowPlaceholders[j] = fmt.Sprintf("$%d", i*len(columns)+j+1)
+		}
+		placeholders[i] = "(" + strings.Join(rowPlaceholders, ", ") + ")"
+
 		args = append(args,
 			round.Name,
 			tournamentID,
+			i,
+			round.Type,
 			round.MatchCount,
 			round.PlayerCount,
 			round.PlayerAdvancementCount,
+			round.LuckyLoserCount,
 			round.GroupSize,
 			round.ConcurrentGroupCount,
+			pointsScheme,
+			mapPool,
+			encodedTieBreakers,
+			round.TieBreakSeed,
+			round.GrandFinalReset,
+			round.RoundRobinCycles,
 		)
 	}
 	roundQuery := fmt.Sprintf(`
-        INSERT INTO rounds (name, tournament_id, match_count, player_count, player_advancement_count, group_size, concurrent_group_count)
-        VALUES %s`, strings.Join(placeholders, ", "))
+        INSERT INTO rounds (%s)
+        VALUES %s`, strings.Join(columns, ", "), strings.Join(placeholders, ", "))
 	_, err := tx.ExecContext(ctx, roundQuery, args...)
 	if err != nil {
 		return fmt.Errorf("error saving rounds: %w", err)
 	}
 	return nil
 }
+
+func (r *TournamentRepository) insertGroup(ctx context.Context, tx *sql.Tx, group *domain.Group, roundID string) error {
+	query := `
+		INSERT INTO groups (name, round_id, position)
+		VALUES ($1, $2, $3)
+		RETURNING id
+	`
+	err := tx.QueryRowContext(ctx, query, group.Name, roundID, group.Position).Scan(&group.Id)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return domain.NewConflictError("the groups of this round have already been drawn")
+		}
+		return fmt.Errorf("error saving group: %w", err)
+	}
+	group.RoundId = roundID
+
+	for seed, player := range group.Players {
+		_, err = tx.ExecContext(ctx, `INSERT INTO player_groups (player_id, group_id, seed) VALUES ($1, $2, $3)`, player.Id, group.Id, seed)
+		if err != nil {
+			return fmt.Errorf("error assigning player to group: %w", err)
+		}
+	}
+
+	for i := range group.Matches {
+		err = insertMatch(ctx, tx, &group.Matches[i], group.Id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// marshalNullableJSON encodes the value as JSON for a nullable JSONB column
+func marshalNullableJSON[T any](value *T) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
@@ -33,7 +33,7 @@ func (r *UserRepository) FindByUsername(ctx context.Context, username string) (*
 		FROM users
 		WHERE username = $1
 	`
-	row := r.db.QueryRowContext(ctx, query, username)
+	row := connFor(ctx, r.db).QueryRowContext(ctx, query, username)
 
 	user := new(domain.User)
 	var createdAt, updatedAt time.Time
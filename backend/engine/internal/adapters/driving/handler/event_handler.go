@@ -1,9 +1,16 @@
 package handler
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"engine/internal/adapters/driven/event"
+	"engine/internal/domain"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/gorilla/websocket"
@@ -17,19 +24,26 @@ var upgrader = websocket.Upgrader{
 	},
 }
 
+// streamKeepAliveInterval is how often a comment is sent on idle event streams, so proxies keep the connection open
+const streamKeepAliveInterval = 30 * time.Second
+
 // clientCommand represents a command sent by a WebSocket client.
+// FromSeq is the last sequence number the client received and Epoch the epoch it was received in;
+// newer retained messages are replayed before live ones.
 type clientCommand struct {
-	Action string `json:"action"`
-	Topic  string `json:"topic"`
+	Action  string  `json:"action"`
+	Topic   string  `json:"topic"`
+	Epoch   string  `json:"epoch"`
+	FromSeq *uint64 `json:"fromSeq"`
 }
 
 // EventHandler handles WebSocket connections for the event broker.
 type EventHandler struct {
-	Broker *event.Broker
+	Broker event.Broker
 }
 
 // NewEventHandler creates a new event handler with the given broker.
-func NewEventHandler(broker *event.Broker) *EventHandler {
+func NewEventHandler(broker event.Broker) *EventHandler {
 	return &EventHandler{
 		Broker: broker,
 	}
@@ -38,6 +52,7 @@ func NewEventHandler(broker *event.Broker) *EventHandler {
 // RegisterRoutes registers the event handler routes.
 func (h *EventHandler) RegisterRoutes(router chi.Router) {
 	router.Get("/events/ws", h.HandleWebSocket)
+	router.Get("/events/stream", h.HandleStream)
 }
 
 // HandleWebSocket upgrades HTTP connections to WebSocket and manages client lifecycle.
@@ -48,7 +63,7 @@ func (h *EventHandler) HandleWebSocket(w http.ResponseWriter, r *http.Request) {
 	}
 
 	client := event.NewClient()
-	h.Broker.NewClients <- client
+	h.Broker.Register(client)
 
 	// Write pump: send messages from client.Send to WebSocket
 	go func() {
@@ -62,7 +77,7 @@ func (h *EventHandler) HandleWebSocket(w http.ResponseWriter, r *http.Request) {
 
 	// Read pump: read commands from WebSocket and handle subscriptions
 	defer func() {
-		h.Broker.Defunct <- client
+		h.Broker.Unregister(client)
 		conn.Close()
 	}()
 
@@ -74,9 +89,105 @@ func (h *EventHandler) HandleWebSocket(w http.ResponseWriter, r *http.Request) {
 
 		switch cmd.Action {
 		case "subscribe":
-			client.SubscribeTo(cmd.Topic)
+			if cmd.FromSeq != nil {
+				h.Broker.SubscribeFrom(client, cmd.Epoch, *cmd.FromSeq, cmd.Topic)
+			} else {
+				client.SubscribeTo(cmd.Topic)
+			}
 		case "unsubscribe":
 			client.UnsubscribeFrom(cmd.Topic)
 		}
 	}
 }
+
+// HandleStream streams the messages of the comma separated topics as Server-Sent Events.
+// The id of every event is the sequence number of the message, so a reconnecting EventSource
+// sends it as Last-Event-ID and the missed messages are replayed before live ones.
+// The epoch of the sequence is part of the message data.
+func (h *EventHandler) HandleStream(w http.ResponseWriter, r *http.Request) {
+	topics := make([]string, 0)
+	for _, value := range r.URL.Query()["topics"] {
+		for _, topic := range strings.Split(value, ",") {
+			if topic = strings.TrimSpace(topic); topic != "" {
+				topics = append(topics, topic)
+			}
+		}
+	}
+	if len(topics) == 0 {
+		panic(domain.NewInvalidParameterError("at least one topic is required"))
+	}
+
+	var lastEventId *uint64
+	if header := r.Header.Get("Last-Event-ID"); header != "" {
+		seq, err := strconv.ParseUint(header, 10, 64)
+		if err != nil {
+			panic(domain.NewInvalidParameterError("invalid Last-Event-ID " + header))
+		}
+		lastEventId = &seq
+	}
+
+	// The stream stays open far longer than the server's write timeout
+	controller := http.NewResponseController(w)
+	if err := controller.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	if err := controller.Flush(); err != nil {
+		return
+	}
+
+	client := event.NewClient()
+	if lastEventId == nil {
+		for _, topic := range topics {
+			client.SubscribeTo(topic)
+		}
+	}
+	h.Broker.Register(client)
+	defer func() {
+		h.Broker.Unregister(client)
+	}()
+	if lastEventId != nil {
+		// The epoch is not part of the id, so the broker resumes in its current epoch
+		h.Broker.SubscribeFrom(client, "", *lastEventId, topics...)
+	}
+
+	keepAlive := time.NewTicker(streamKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		case data, ok := <-client.Send:
+			if !ok {
+				return
+			}
+			if err := writeStreamEvent(w, data); err != nil {
+				return
+			}
+		}
+		if err := controller.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// writeStreamEvent writes a broker message as a Server-Sent Event with its sequence number as id
+func writeStreamEvent(w http.ResponseWriter, data []byte) error {
+	var message struct {
+		Seq uint64 `json:"seq"`
+	}
+	if err := json.Unmarshal(data, &message); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(w, "id: %d\ndata: %s\n\n", message.Seq, data)
+	return err
+}
@@ -61,9 +61,7 @@ func (h *PlayerHandler) CreatePlayer(w http.ResponseWriter, r *http.Request) {
 
 	var req = validation.ValidateRequest[requests.CreatePlayerRequest](r)
 
-	player := h.playerService.CreatePlayer(ctx, req.Name, tournament.Id)
-
-	h.qualifyingService.AddPlayerToQualifying(ctx, tournament.Id, player.Id)
+	player := h.qualifyingService.SignUpPlayer(ctx, tournament.Id, req.Name)
 
 	response.Send(w, r, http.StatusCreated, player)
 }
@@ -88,6 +86,11 @@ func (h *PlayerHandler) DeletePlayer(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	h.playerService.DeletePlayer(r.Context(), params.Id)
+	ctx := r.Context()
+	tournament := ctx.Value(middleware.TournamentKey{}).(*domain.Tournament)
+
+	// A withdrawal before the first round frees a spot for the best waitlisted player
+	h.qualifyingService.WithdrawPlayer(ctx, tournament.Id, params.Id)
+
 	response.Send(w, r, http.StatusOK, nil)
 }
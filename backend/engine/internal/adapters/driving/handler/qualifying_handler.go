@@ -1,7 +1,12 @@
 package handler
 
 import (
+	"encoding/json"
+	"engine/internal/adapters/driving/requests"
 	"engine/internal/adapters/driving/response"
+	"engine/internal/adapters/driving/validation"
+	"engine/internal/domain"
+	"engine/internal/middleware"
 	"engine/internal/ports/input"
 	"net/http"
 
@@ -20,6 +25,14 @@ func NewQualifyingHandler(qualifyingService input.QualifyingServiceInterface) *Q
 
 func (h *QualifyingHandler) RegisterRoutes(router chi.Router) {
 	router.Get("/", h.GetQualifying)
+	router.Get("/{playerId}/attempts", h.ListQualifyingAttempts)
+
+	router.Group(func(router chi.Router) {
+		router.Use(middleware.TournamentActiveMiddleware())
+		router.Put("/{playerId}", h.SubmitQualifyingTime)
+		router.Post("/open", h.OpenQualifying)
+		router.Post("/close", h.CloseQualifying)
+	})
 }
 
 func (h *QualifyingHandler) GetQualifying(w http.ResponseWriter, r *http.Request) {
@@ -28,3 +41,56 @@ func (h *QualifyingHandler) GetQualifying(w http.ResponseWriter, r *http.Request
 	qualifying := h.qualifyingService.GetQualifyingByTournamentId(ctx, id)
 	response.Send(w, r, http.StatusOK, qualifying)
 }
+
+func (h *QualifyingHandler) SubmitQualifyingTime(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tournament := ctx.Value(middleware.TournamentKey{}).(*domain.Tournament)
+
+	req := validation.ValidateRequest[requests.SubmitQualifyingTimeRequest](r)
+
+	// The time is either a number of milliseconds or a string like "1:23.456"
+	value := string(req.Time)
+	var text string
+	if err := json.Unmarshal(req.Time, &text); err == nil {
+		value = text
+	}
+
+	milliseconds, err := domain.ParseQualifyingTime(value)
+	if err != nil {
+		panic(err)
+	}
+
+	player := h.qualifyingService.SubmitQualifyingTime(ctx, tournament.Id, chi.URLParam(r, "playerId"), milliseconds)
+	response.Send(w, r, http.StatusOK, player)
+}
+
+func (h *QualifyingHandler) ListQualifyingAttempts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tournament := ctx.Value(middleware.TournamentKey{}).(*domain.Tournament)
+
+	attempts := h.qualifyingService.ListQualifyingAttempts(ctx, tournament.Id, chi.URLParam(r, "playerId"))
+	response.Send(w, r, http.StatusOK, attempts)
+}
+
+func (h *QualifyingHandler) OpenQualifying(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tournament := ctx.Value(middleware.TournamentKey{}).(*domain.Tournament)
+
+	tournament = h.qualifyingService.OpenQualifying(ctx, tournament.Id)
+	response.Send(w, r, http.StatusOK, tournament)
+}
+
+func (h *QualifyingHandler) CloseQualifying(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tournament := ctx.Value(middleware.TournamentKey{}).(*domain.Tournament)
+
+	req := validation.ValidateRequest[requests.CloseQualifyingRequest](r)
+
+	overflow := domain.PlayerWaitlisted
+	if req.Overflow != "" {
+		overflow = domain.PlayerStatus(req.Overflow)
+	}
+
+	tournament = h.qualifyingService.CloseQualifying(ctx, tournament.Id, overflow)
+	response.Send(w, r, http.StatusOK, tournament)
+}
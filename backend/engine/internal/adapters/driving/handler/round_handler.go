@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"engine/internal/adapters/driving/requests"
+	"engine/internal/adapters/driving/response"
+	"engine/internal/adapters/driving/validation"
+	"engine/internal/domain"
+	"engine/internal/middleware"
+	"engine/internal/ports/input"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type RoundHandler struct {
+	matchService     input.MatchServiceInterface
+	standingsService input.StandingsServiceInterface
+}
+
+func NewRoundHandler(matchService input.MatchServiceInterface, standingsService input.StandingsServiceInterface) *RoundHandler {
+	return &RoundHandler{
+		matchService:     matchService,
+		standingsService: standingsService,
+	}
+}
+
+func (h *RoundHandler) RegisterRoutes(router chi.Router) {
+	router.Route("/{roundId}/group/{groupId}", func(router chi.Router) {
+		router.Get("/standings", h.GetGroupStandings)
+		router.Post("/match/{matchId}/placements", h.RecordPlacements)
+		router.Put("/match/{matchId}/map", h.SetMap)
+	})
+}
+
+func (h *RoundHandler) GetGroupStandings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tournament := ctx.Value(middleware.TournamentKey{}).(*domain.Tournament)
+
+	standings := h.standingsService.GetGroupStandings(ctx, tournament.Id, chi.URLParam(r, "roundId"), chi.URLParam(r, "groupId"))
+	response.Send(w, r, http.StatusOK, standings)
+}
+
+func (h *RoundHandler) RecordPlacements(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tournament := ctx.Value(middleware.TournamentKey{}).(*domain.Tournament)
+
+	req := validation.ValidateRequest[requests.RecordPlacementsRequest](r)
+
+	placements := make([]domain.Placement, 0, len(req.Placements))
+	for _, placement := range req.Placements {
+		placements = append(placements, domain.Placement{
+			PlayerId:  placement.PlayerId,
+			Placement: placement.Placement,
+		})
+	}
+
+	match := h.matchService.RecordPlacements(
+		ctx,
+		tournament.Id,
+		chi.URLParam(r, "roundId"),
+		chi.URLParam(r, "groupId"),
+		chi.URLParam(r, "matchId"),
+		placements,
+	)
+	response.Send(w, r, http.StatusCreated, match)
+}
+
+func (h *RoundHandler) SetMap(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tournament := ctx.Value(middleware.TournamentKey{}).(*domain.Tournament)
+
+	req := validation.ValidateRequest[requests.SetMapRequest](r)
+
+	match := h.matchService.SetMapName(
+		ctx,
+		tournament.Id,
+		chi.URLParam(r, "roundId"),
+		chi.URLParam(r, "groupId"),
+		chi.URLParam(r, "matchId"),
+		req.MapName,
+	)
+	response.Send(w, r, http.StatusOK, match)
+}
@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"engine/internal/adapters/driving/requests"
+	"engine/internal/adapters/driving/response"
+	"engine/internal/adapters/driving/validation"
+	"engine/internal/domain"
+	"engine/internal/middleware"
+	"engine/internal/ports/input"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type ScheduleHandler struct {
+	scheduleService input.ScheduleServiceInterface
+}
+
+func NewScheduleHandler(scheduleService input.ScheduleServiceInterface) *ScheduleHandler {
+	return &ScheduleHandler{
+		scheduleService: scheduleService,
+	}
+}
+
+func (h *ScheduleHandler) RegisterRoutes(router chi.Router) {
+	router.Get("/", h.GetSchedule)
+	router.Post("/delay", h.DelaySlot)
+}
+
+func (h *ScheduleHandler) GetSchedule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tournament := ctx.Value(middleware.TournamentKey{}).(*domain.Tournament)
+
+	schedule := h.scheduleService.GetSchedule(ctx, tournament.Id)
+	response.Send(w, r, http.StatusOK, schedule)
+}
+
+// GetCalendar exports the schedule as iCalendar file. The playerId query parameter limits it to the groups of a player.
+func (h *ScheduleHandler) GetCalendar(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tournament := ctx.Value(middleware.TournamentKey{}).(*domain.Tournament)
+
+	playerId := r.URL.Query().Get("playerId")
+	events := h.scheduleService.GetCalendar(ctx, tournament.Id, playerId)
+
+	filename := "tournament-" + tournament.Id + ".ics"
+	if playerId != "" {
+		filename = "tournament-" + tournament.Id + "-" + playerId + ".ics"
+	}
+	response.SendCalendar(w, r, filename, events)
+}
+
+func (h *ScheduleHandler) DelaySlot(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tournament := ctx.Value(middleware.TournamentKey{}).(*domain.Tournament)
+
+	req := validation.ValidateRequest[requests.DelayScheduleRequest](r)
+
+	schedule := h.scheduleService.DelaySlot(ctx, tournament.Id, domain.ScheduleDelay{
+		RoundId: req.RoundId,
+		Slot:    req.Slot,
+		Minutes: req.Minutes,
+		Reason:  req.Reason,
+	})
+	response.Send(w, r, http.StatusCreated, schedule)
+}
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"engine/internal/adapters/driving/requests"
+	"engine/internal/adapters/driving/response"
+	"engine/internal/adapters/driving/validation"
+	"engine/internal/domain"
+	"engine/internal/middleware"
+	"engine/internal/ports/input"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type StationHandler struct {
+	stationService input.StationServiceInterface
+}
+
+func NewStationHandler(stationService input.StationServiceInterface) *StationHandler {
+	return &StationHandler{
+		stationService: stationService,
+	}
+}
+
+func (h *StationHandler) RegisterRoutes(router chi.Router) {
+	router.Get("/", h.ListStations)
+	router.Post("/", h.CreateStation)
+	router.Delete("/{stationId}", h.DeleteStation)
+}
+
+func (h *StationHandler) ListStations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tournament := ctx.Value(middleware.TournamentKey{}).(*domain.Tournament)
+
+	stations := h.stationService.ListStations(ctx, tournament.Id)
+	response.Send(w, r, http.StatusOK, stations)
+}
+
+func (h *StationHandler) CreateStation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tournament := ctx.Value(middleware.TournamentKey{}).(*domain.Tournament)
+
+	req := validation.ValidateRequest[requests.CreateStationRequest](r)
+
+	station := h.stationService.CreateStation(ctx, &domain.Station{
+		TournamentId: tournament.Id,
+		Number:       req.Number,
+		Name:         req.Name,
+	})
+	response.Send(w, r, http.StatusCreated, station)
+}
+
+func (h *StationHandler) DeleteStation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tournament := ctx.Value(middleware.TournamentKey{}).(*domain.Tournament)
+
+	h.stationService.DeleteStation(ctx, tournament.Id, chi.URLParam(r, "stationId"))
+	response.Send(w, r, http.StatusOK, nil)
+}
@@ -1,14 +1,12 @@
 package handler
 
 import (
-	"encoding/json"
 	"engine/internal/adapters/driving/requests"
 	"engine/internal/adapters/driving/response"
 	"engine/internal/adapters/driving/validation"
 	"engine/internal/domain"
 	"engine/internal/middleware"
 	"engine/internal/ports/input"
-	"errors"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
@@ -18,17 +16,29 @@ type TournamentHandler struct {
 	tournamentService input.TournamentServiceInterface
 	playerService     input.PlayerServiceInterface
 	qualifyingService input.QualifyingServiceInterface
+	matchService      input.MatchServiceInterface
+	standingsService  input.StandingsServiceInterface
+	scheduleService   input.ScheduleServiceInterface
+	stationService    input.StationServiceInterface
 }
 
 func NewTournamentHandler(
 	tournamentService input.TournamentServiceInterface,
 	playerService input.PlayerServiceInterface,
 	qualifyingService input.QualifyingServiceInterface,
+	matchService input.MatchServiceInterface,
+	standingsService input.StandingsServiceInterface,
+	scheduleService input.ScheduleServiceInterface,
+	stationService input.StationServiceInterface,
 ) *TournamentHandler {
 	return &TournamentHandler{
 		tournamentService: tournamentService,
 		playerService:     playerService,
 		qualifyingService: qualifyingService,
+		matchService:      matchService,
+		standingsService:  standingsService,
+		scheduleService:   scheduleService,
+		stationService:    stationService,
 	}
 }
 
@@ -41,12 +51,26 @@ func (h *TournamentHandler) RegisterRoutes(router chi.Router) {
 	qualifyingHandler := NewQualifyingHandler(h.qualifyingService)
 	qualifyingHandler.RegisterRoutes(qualifyingRouter)
 
+	roundRouter := chi.NewRouter()
+	roundHandler := NewRoundHandler(h.matchService, h.standingsService)
+	roundHandler.RegisterRoutes(roundRouter)
+
+	scheduleRouter := chi.NewRouter()
+	scheduleHandler := NewScheduleHandler(h.scheduleService)
+	scheduleHandler.RegisterRoutes(scheduleRouter)
+
+	stationRouter := chi.NewRouter()
+	stationHandler := NewStationHandler(h.stationService)
+	stationHandler.RegisterRoutes(stationRouter)
+
 	router.Route("/tournament", func(router chi.Router) {
 		router.Get("/", h.ListTournaments)
 		router.Post("/", h.CreateTournament)
 		router.Route("/{id}", func(router chi.Router) {
 			router.Use(middleware.TournamentMiddleware(h.tournamentService))
 			router.Patch("/status", h.UpdateTournamentStatus)
+			router.Post("/pause", h.PauseTournament)
+			router.Post("/resume", h.ResumeTournament)
 			router.Get("/", h.GetTournament)
 			router.Group(func(router chi.Router) {
 				router.Use(middleware.TournamentActiveMiddleware())
@@ -54,6 +78,10 @@ func (h *TournamentHandler) RegisterRoutes(router chi.Router) {
 			})
 			router.Mount("/player", playerRouter)
 			router.Mount("/qualifying", qualifyingRouter)
+			router.Mount("/round", roundRouter)
+			router.Get("/schedule.ics", scheduleHandler.GetCalendar)
+			router.Mount("/schedule", scheduleRouter)
+			router.Mount("/station", stationRouter)
 		})
 	})
 }
@@ -79,41 +107,46 @@ func (h *TournamentHandler) ListTournaments(w http.ResponseWriter, r *http.Reque
 
 func (h *TournamentHandler) UpdateTournamentStatus(w http.ResponseWriter, r *http.Request) {
 	id := chi.URLParam(r, "id")
-	var req requests.UpdateTournamentStatusRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		response.SendError(w, r, http.StatusBadRequest, err.Error())
-		return
-	}
+	req := validation.ValidateRequest[requests.UpdateTournamentStatusRequest](r)
 
-	status, err := h.parseStatusString(req.Status)
+	status, err := domain.ParseTournamentStatus(req.Status)
 	if err != nil {
 		response.SendError(w, r, http.StatusBadRequest, err.Error())
 		return
 	}
 
 	ctx := r.Context()
+	if status == domain.StatusPaused {
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		tournament := h.tournamentService.PauseTournament(ctx, id, userID, req.Reason)
+		response.Send(w, r, http.StatusOK, tournament)
+		return
+	}
+
 	tournament := h.tournamentService.UpdateTournamentStatus(ctx, id, status)
 	response.Send(w, r, http.StatusOK, tournament)
 }
 
+func (h *TournamentHandler) PauseTournament(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	req := validation.ValidateRequest[requests.PauseTournamentRequest](r)
+
+	ctx := r.Context()
+	userID, _ := middleware.GetUserIDFromContext(ctx)
+	tournament := h.tournamentService.PauseTournament(ctx, id, userID, req.Reason)
+	response.Send(w, r, http.StatusOK, tournament)
+}
+
+func (h *TournamentHandler) ResumeTournament(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	ctx := r.Context()
+	tournament := h.tournamentService.ResumeTournament(ctx, id)
+	response.Send(w, r, http.StatusOK, tournament)
+}
+
 func (h *TournamentHandler) DeleteTournament(w http.ResponseWriter, r *http.Request) {
 	id := chi.URLParam(r, "id")
 	ctx := r.Context()
 	h.tournamentService.DeleteTournament(ctx, id)
 	response.Send(w, r, http.StatusOK, nil)
 }
-
-func (h *TournamentHandler) parseStatusString(statusStr string) (domain.TournamentStatus, error) {
-	switch statusStr {
-	case "DRAFT":
-		return domain.StatusDraft, nil
-	case "ACTIVE":
-		return domain.StatusActive, nil
-	case "COMPLETED":
-		return domain.StatusCompleted, nil
-	case "CANCELLED":
-		return domain.StatusCancelled, nil
-	default:
-		return "", errors.New("invalid status")
-	}
-}
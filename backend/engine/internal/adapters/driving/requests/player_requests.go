@@ -1,5 +1,7 @@
 package requests
 
+import "encoding/json"
+
 type CreatePlayerRequest struct {
 	Name string `json:"name" validate:"required,min=3,max=255"`
 }
@@ -11,3 +13,11 @@ type DeletePlayerRequest struct {
 type UpdatePlayerRequest struct {
 	Name string `json:"name" validate:"required,min=3,max=255"`
 }
+
+type SubmitQualifyingTimeRequest struct {
+	Time json.RawMessage `json:"time" validate:"required"`
+}
+
+type CloseQualifyingRequest struct {
+	Overflow string `json:"overflow" validate:"omitempty,oneof=WAITLISTED ELIMINATED"`
+}
@@ -1,5 +1,7 @@
 package requests
 
+import "time"
+
 type CreateTournamentRequest struct {
 	Name                   string                         `json:"name" validate:"required,min=3,max=255"`
 	Description            string                         `json:"description" validate:"required,min=3,max=255"`
@@ -7,18 +9,87 @@ type CreateTournamentRequest struct {
 	EndDate                string                         `json:"endDate" validate:"required"`
 	AllowUnderfilledGroups bool                           `json:"allowUnderfilledGroups"`
 	PlayerCount            int                            `json:"playerCount" validate:"required,min=1"`
+	SeedingStrategy        string                         `json:"seedingStrategy" validate:"omitempty,oneof=SNAKE SEQUENTIAL RANDOM"`
+	SeedingSeed            *int64                         `json:"seedingSeed"`
+	PointsScheme           *PointsSchemeRequest           `json:"pointsScheme"`
+	MapPool                *MapPoolRequest                `json:"mapPool"`
+	Qualifying             *QualifyingConfigRequest       `json:"qualifying"`
+	Schedule               *ScheduleConfigRequest         `json:"schedule"`
 	Rounds                 []CreateTournamentRoundRequest `json:"rounds"`
 }
 
 type CreateTournamentRoundRequest struct {
-	Name                   string `json:"name" validate:"required,min=3,max=255"`
-	MatchCount             int    `json:"matchCount" validate:"required,min=1"`
-	PlayerAdvancementCount int    `json:"playerAdvancementCount" validate:"required,min=0"`
-	GroupSize              int    `json:"groupSize" validate:"required,min=2"`
-	GroupCount             int    `json:"groupCount" validate:"required,min=1"`
-	ConcurrentGroupCount   int    `json:"concurrentGroupCount" validate:"required,min=1"`
+	Name                   string               `json:"name" validate:"required,min=3,max=255"`
+	Type                   string               `json:"type" validate:"omitempty,oneof=GROUP SWISS ROUND_ROBIN SINGLE_ELIMINATION DOUBLE_ELIMINATION"`
+	MatchCount             int                  `json:"matchCount" validate:"min=0"`
+	PlayerAdvancementCount int                  `json:"playerAdvancementCount" validate:"required,min=0"`
+	LuckyLoserCount        int                  `json:"luckyLoserCount" validate:"min=0"`
+	GroupSize              int                  `json:"groupSize" validate:"required,min=2"`
+	GroupCount             int                  `json:"groupCount" validate:"required,min=1"`
+	ConcurrentGroupCount   int                  `json:"concurrentGroupCount" validate:"required,min=1"`
+	PointsScheme           *PointsSchemeRequest `json:"pointsScheme"`
+	MapPool                *MapPoolRequest      `json:"mapPool"`
+	TieBreakers            []string             `json:"tieBreakers"`
+	TieBreakSeed           *int64               `json:"tieBreakSeed"`
+	GrandFinalReset        bool                 `json:"grandFinalReset"`
+	RoundRobinCycles       int                  `json:"roundRobinCycles" validate:"min=0"`
+}
+
+type PointsSchemeRequest struct {
+	Type  string `json:"type" validate:"required,oneof=MARIO_KART F1 LINEAR CUSTOM"`
+	Table []int  `json:"table" validate:"required_if=Type CUSTOM,dive,min=0"`
+}
+
+type MapPoolRequest struct {
+	Maps     []string `json:"maps" validate:"required,min=1,unique,dive,required,max=255"`
+	Strategy string   `json:"strategy" validate:"omitempty,oneof=ROTATION RANDOM SEED_PICK"`
+	Seed     *int64   `json:"seed"`
+}
+
+type QualifyingConfigRequest struct {
+	AttemptLimit int        `json:"attemptLimit" validate:"min=0"`
+	Mode         string     `json:"mode" validate:"omitempty,oneof=BEST AVERAGE"`
+	OpensAt      *time.Time `json:"opensAt"`
+	ClosesAt     *time.Time `json:"closesAt"`
+}
+
+type ScheduleConfigRequest struct {
+	StartsAt             time.Time `json:"startsAt" validate:"required"`
+	MatchDurationMinutes int       `json:"matchDurationMinutes" validate:"required,min=1"`
+	StationCount         int       `json:"stationCount" validate:"min=0"`
+}
+
+type DelayScheduleRequest struct {
+	RoundId string `json:"roundId" validate:"required"`
+	Slot    int    `json:"slot" validate:"min=0"`
+	Minutes int    `json:"minutes" validate:"required,min=1"`
+	Reason  string `json:"reason" validate:"max=255"`
+}
+
+type CreateStationRequest struct {
+	Number int    `json:"number" validate:"min=0"`
+	Name   string `json:"name" validate:"max=255"`
 }
 
 type UpdateTournamentStatusRequest struct {
 	Status string `json:"status" validate:"required"`
+	// Reason is required when pausing, with the same bounds as PauseTournamentRequest
+	Reason string `json:"reason" validate:"required_if=Status PAUSED,omitempty,min=3,max=255"`
+}
+
+type PauseTournamentRequest struct {
+	Reason string `json:"reason" validate:"required,min=3,max=255"`
+}
+
+type RecordPlacementsRequest struct {
+	Placements []PlacementRequest `json:"placements" validate:"required,min=1,dive"`
+}
+
+type SetMapRequest struct {
+	MapName string `json:"mapName" validate:"required,max=255"`
+}
+
+type PlacementRequest struct {
+	PlayerId  string `json:"playerId" validate:"required"`
+	Placement int    `json:"placement" validate:"required,min=1"`
 }
@@ -0,0 +1,76 @@
+package response
+
+import (
+	"engine/internal/domain"
+	"net/http"
+	"strings"
+)
+
+const (
+	calendarDateLayout     = "20060102"
+	calendarDateTimeLayout = "20060102T150405Z"
+	// calendarLineLength is the maximum length of a content line in octets before it has to be folded (RFC 5545, 3.1)
+	calendarLineLength = 75
+)
+
+var calendarTextEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// SendCalendar renders the events as an iCalendar (RFC 5545) document
+func SendCalendar(w http.ResponseWriter, r *http.Request, filename string, events []domain.CalendarEvent) {
+	var calendar strings.Builder
+	writeLine := func(line string) {
+		calendar.WriteString(foldCalendarLine(line))
+		calendar.WriteString("\r\n")
+	}
+
+	stamp := getRequestStartTime(r).UTC().Format(calendarDateTimeLayout)
+
+	writeLine("BEGIN:VCALENDAR")
+	writeLine("VERSION:2.0")
+	writeLine("PRODID:-//Tournament Engine//Schedule//EN")
+	writeLine("CALSCALE:GREGORIAN")
+	writeLine("METHOD:PUBLISH")
+	for _, event := range events {
+		writeLine("BEGIN:VEVENT")
+		writeLine("UID:" + event.UID)
+		writeLine("DTSTAMP:" + stamp)
+		if event.AllDay {
+			writeLine("DTSTART;VALUE=DATE:" + event.Start.Format(calendarDateLayout))
+			writeLine("DTEND;VALUE=DATE:" + event.End.Format(calendarDateLayout))
+		} else {
+			writeLine("DTSTART:" + event.Start.UTC().Format(calendarDateTimeLayout))
+			writeLine("DTEND:" + event.End.UTC().Format(calendarDateTimeLayout))
+		}
+		writeLine("SUMMARY:" + calendarTextEscaper.Replace(event.Summary))
+		if event.Description != "" {
+			writeLine("DESCRIPTION:" + calendarTextEscaper.Replace(event.Description))
+		}
+		writeLine("END:VEVENT")
+	}
+	writeLine("END:VCALENDAR")
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(calendar.String()))
+}
+
+// foldCalendarLine splits lines longer than 75 octets into continuation lines without breaking UTF-8 sequences
+func foldCalendarLine(line string) string {
+	if len(line) <= calendarLineLength {
+		return line
+	}
+
+	var folded strings.Builder
+	length := 0
+	for _, character := range line {
+		size := len(string(character))
+		if length+size > calendarLineLength {
+			folded.WriteString("\r\n ")
+			length = 1
+		}
+		folded.WriteRune(character)
+		length += size
+	}
+	return folded.String()
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"engine/internal/adapters/driving/requests"
 	"engine/internal/domain"
+	"fmt"
 	"net/http"
 
 	"github.com/go-playground/validator/v10"
@@ -36,9 +37,24 @@ func ValidateCreateTournamentRequest(r *http.Request) *requests.CreateTournament
 		panic(domain.NewInvalidParameterError("Invalid request parameters"))
 	}
 
+	if req.Qualifying != nil && req.Qualifying.OpensAt != nil && req.Qualifying.ClosesAt != nil {
+		if !req.Qualifying.ClosesAt.After(*req.Qualifying.OpensAt) {
+			panic(domain.NewInvalidParameterError("Qualifying must close after it opens"))
+		}
+	}
+
 	var previousRound *requests.CreateTournamentRoundRequest
+	var previousAdvancingCount int
 
 	for _, round := range req.Rounds {
+		domainRound := domain.Round{
+			Type:                   domain.RoundType(round.Type),
+			PlayerCount:            round.GroupCount * round.GroupSize,
+			GroupSize:              round.GroupSize,
+			PlayerAdvancementCount: round.PlayerAdvancementCount,
+			RoundRobinCycles:       round.RoundRobinCycles,
+		}
+
 		if req.AllowUnderfilledGroups == false {
 			var playersInRound = round.GroupCount * round.GroupSize
 
@@ -46,7 +62,7 @@ func ValidateCreateTournamentRequest(r *http.Request) *requests.CreateTournament
 				if playersInRound != req.PlayerCount {
 					panic(domain.NewInvalidParameterError("Number of players in first round must be equal to total players in tournament"))
 				}
-			} else if playersInRound != (previousRound.PlayerAdvancementCount * previousRound.GroupCount) {
+			} else if playersInRound != previousAdvancingCount {
 				panic(domain.NewInvalidParameterError("Number of players in round must be equal to total advancing players of previous round"))
 			}
 		}
@@ -59,11 +75,45 @@ func ValidateCreateTournamentRequest(r *http.Request) *requests.CreateTournament
 			panic(domain.NewInvalidParameterError("Group count must be greater than 0"))
 		}
 
+		// Round-robin groups play every pairing, so their match count follows from the group size
+		if domainRound.Type == domain.RoundTypeRoundRobin {
+			if round.MatchCount != 0 && round.MatchCount != domainRound.RoundRobinMatchCount() {
+				panic(domain.NewInvalidParameterError(fmt.Sprintf("Match count of round-robin round %s must be %d or omitted", round.Name, domainRound.RoundRobinMatchCount())))
+			}
+		} else if round.MatchCount < 1 {
+			panic(domain.NewInvalidParameterError("Match count must be greater than 0"))
+		}
+
+		if domain.RoundType(round.Type).IsElimination() && round.GroupSize != 2 {
+			panic(domain.NewInvalidParameterError("Elimination rounds require a group size of 2"))
+		}
+
 		if round.PlayerAdvancementCount > round.GroupSize {
 			panic(domain.NewInvalidParameterError("Player advancement count cannot exceed total players in group"))
 		}
 
+		if round.LuckyLoserCount > domainRound.NonQualifiedCount() {
+			panic(domain.NewInvalidParameterError("Lucky loser count cannot exceed non-qualified players of round " + round.Name))
+		}
+
+		if round.PointsScheme != nil {
+			if err := validate.Struct(round.PointsScheme); err != nil {
+				panic(domain.NewInvalidParameterError("Invalid points scheme for round " + round.Name))
+			}
+		}
+
+		if round.MapPool != nil {
+			if err := validate.Struct(round.MapPool); err != nil {
+				panic(domain.NewInvalidParameterError("Invalid map pool for round " + round.Name))
+			}
+		}
+
+		if err := validate.Var(round.TieBreakers, "omitempty,unique,dive,oneof=MOST_WINS BEST_PLACEMENT HEAD_TO_HEAD QUALIFYING_TIME BUCHHOLZ SONNEBORN_BERGER COIN_FLIP"); err != nil {
+			panic(domain.NewInvalidParameterError("Invalid tie-breakers for round " + round.Name))
+		}
+
 		previousRound = &round
+		previousAdvancingCount = domainRound.QualifiedCount() + round.LuckyLoserCount
 	}
 
 	if previousRound == nil {
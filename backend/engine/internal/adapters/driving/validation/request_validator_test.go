@@ -0,0 +1,124 @@
+package validation
+
+import (
+	"encoding/json"
+	"engine/internal/adapters/driving/requests"
+	"engine/internal/domain"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// validateCreateTournament runs the request validation and returns the error it panics with
+func validateCreateTournament(t *testing.T, req requests.CreateTournamentRequest) (err error) {
+	body, marshalErr := json.Marshal(req)
+	if marshalErr != nil {
+		t.Fatalf("Expected the request to be encoded, got %v", marshalErr)
+	}
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = recovered.(error)
+		}
+	}()
+	ValidateCreateTournamentRequest(httptest.NewRequest(http.MethodPost, "/api/tournament", strings.NewReader(string(body))))
+	return nil
+}
+
+// tournamentWithRounds returns a valid tournament request for the given rounds
+func tournamentWithRounds(playerCount int, rounds ...requests.CreateTournamentRoundRequest) requests.CreateTournamentRequest {
+	return requests.CreateTournamentRequest{
+		Name:        "Cup",
+		Description: "Test cup",
+		StartDate:   "2026-10-17",
+		EndDate:     "2026-10-18",
+		PlayerCount: playerCount,
+		Rounds:      rounds,
+	}
+}
+
+func TestValidateLuckyLoserCount(t *testing.T) {
+	final := func(playerCount int) requests.CreateTournamentRoundRequest {
+		return requests.CreateTournamentRoundRequest{Name: "Final", MatchCount: 1, PlayerAdvancementCount: 1, GroupSize: playerCount, GroupCount: 1, ConcurrentGroupCount: 1}
+	}
+
+	tests := []struct {
+		name        string
+		round       requests.CreateTournamentRoundRequest
+		luckyLosers int
+		finalists   int
+		valid       bool
+	}{
+		{"groups", requests.CreateTournamentRoundRequest{Type: "GROUP", GroupSize: 4, GroupCount: 2, PlayerAdvancementCount: 2}, 4, 8, true},
+		{"groups above non-qualified", requests.CreateTournamentRoundRequest{Type: "GROUP", GroupSize: 4, GroupCount: 2, PlayerAdvancementCount: 2}, 5, 9, false},
+		{"swiss pool", requests.CreateTournamentRoundRequest{Type: "SWISS", GroupSize: 4, GroupCount: 2, PlayerAdvancementCount: 1}, 6, 8, true},
+		{"swiss pool above non-qualified", requests.CreateTournamentRoundRequest{Type: "SWISS", GroupSize: 4, GroupCount: 2, PlayerAdvancementCount: 1}, 7, 9, false},
+		{"elimination bracket", requests.CreateTournamentRoundRequest{Type: "SINGLE_ELIMINATION", GroupSize: 2, GroupCount: 4, PlayerAdvancementCount: 1}, 4, 8, true},
+		{"elimination bracket above non-qualified", requests.CreateTournamentRoundRequest{Type: "SINGLE_ELIMINATION", GroupSize: 2, GroupCount: 4, PlayerAdvancementCount: 1}, 5, 9, false},
+		{"elimination bracket without qualifiers", requests.CreateTournamentRoundRequest{Type: "DOUBLE_ELIMINATION", GroupSize: 2, GroupCount: 4, PlayerAdvancementCount: 2}, 1, 9, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			round := test.round
+			round.Name = "Round 1"
+			round.MatchCount = 3
+			round.ConcurrentGroupCount = 1
+			round.LuckyLoserCount = test.luckyLosers
+
+			err := validateCreateTournament(t, tournamentWithRounds(round.GroupSize*round.GroupCount, round, final(test.finalists)))
+			if test.valid && err != nil {
+				t.Errorf("Expected the request to be valid, got %v", err)
+			}
+			if !test.valid && !domain.IsInvalidParameter(err) {
+				t.Errorf("Expected an invalid parameter error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateAdvancingPlayersOfSinglePool(t *testing.T) {
+	swiss := requests.CreateTournamentRoundRequest{Name: "Swiss", Type: "SWISS", MatchCount: 3, PlayerAdvancementCount: 1, LuckyLoserCount: 2, GroupSize: 4, GroupCount: 4, ConcurrentGroupCount: 1}
+	final := requests.CreateTournamentRoundRequest{Name: "Final", MatchCount: 1, PlayerAdvancementCount: 1, GroupSize: 6, GroupCount: 1, ConcurrentGroupCount: 1}
+
+	// One player per table of the pool and two lucky losers reach the final
+	if err := validateCreateTournament(t, tournamentWithRounds(16, swiss, final)); err != nil {
+		t.Errorf("Expected the request to be valid, got %v", err)
+	}
+
+	final.GroupSize = 5
+	if err := validateCreateTournament(t, tournamentWithRounds(16, swiss, final)); !domain.IsInvalidParameter(err) {
+		t.Errorf("Expected a final that does not match the advancing players to be rejected, got %v", err)
+	}
+}
+
+func TestValidateRoundRobinMatchCount(t *testing.T) {
+	tests := []struct {
+		name       string
+		roundType  string
+		matchCount int
+		valid      bool
+	}{
+		{"round robin without match count", "ROUND_ROBIN", 0, true},
+		{"round robin with matching match count", "ROUND_ROBIN", 12, true},
+		{"round robin with other match count", "ROUND_ROBIN", 3, false},
+		{"groups without match count", "GROUP", 0, false},
+		{"groups with match count", "GROUP", 3, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Four players meet each other twice in 12 matches
+			round := requests.CreateTournamentRoundRequest{Name: "Round 1", Type: test.roundType, MatchCount: test.matchCount, PlayerAdvancementCount: 1, GroupSize: 4, GroupCount: 1, ConcurrentGroupCount: 1, RoundRobinCycles: 2}
+
+			err := validateCreateTournament(t, tournamentWithRounds(4, round))
+			if test.valid && err != nil {
+				t.Errorf("Expected the request to be valid, got %v", err)
+			}
+			if !test.valid && !domain.IsInvalidParameter(err) {
+				t.Errorf("Expected an invalid parameter error, got %v", err)
+			}
+		})
+	}
+}
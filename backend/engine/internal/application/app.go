@@ -15,6 +15,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	chiMiddleware "github.com/go-chi/chi/v5/middleware"
@@ -32,12 +33,23 @@ type App struct {
 	userRepository       output.UserRepositoryInterface
 	playerRepository     output.PlayerRepositoryInterface
 	qualifyingRepository output.QualifyingRepositoryInterface
+	matchRepository      output.MatchRepositoryInterface
+	scheduleRepository   output.ScheduleRepositoryInterface
+	stationRepository    output.StationRepositoryInterface
+	outboxRepository     output.OutboxRepositoryInterface
+	transactionManager   output.TransactionManagerInterface
+	leaderLock           output.LeaderLockInterface
 
 	// Services
 	tournamentService     input.TournamentServiceInterface
 	userService           input.UserServiceInterface
 	playerService         input.PlayerServiceInterface
 	qualifyingService     input.QualifyingServiceInterface
+	matchService          input.MatchServiceInterface
+	standingsService      input.StandingsServiceInterface
+	scheduleService       input.ScheduleServiceInterface
+	stationService        input.StationServiceInterface
+	outboxService         input.OutboxServiceInterface
 	authenticationService *service.AuthenticationService
 	authorizationService  *service.AuthorizationService
 
@@ -46,9 +58,24 @@ type App struct {
 	eventHandler      *handler.EventHandler
 
 	// Broker
-	broker *event.Broker
+	broker event.Broker
+
+	// stopWorkers stops the background workers
+	stopWorkers context.CancelFunc
 }
 
+// qualifyingWindowInterval is how often scheduled qualifying windows are checked for opening or closing
+const qualifyingWindowInterval = time.Second
+
+// outboxDispatchInterval is how often the outbox is checked for events to publish
+const outboxDispatchInterval = 250 * time.Millisecond
+
+// leaderLockInterval is how often replicas try to take over a leader lock and the leader checks that it still holds it
+const leaderLockInterval = 10 * time.Second
+
+// qualifyingWindowLock is the leader lock of the qualifying window watcher, which runs on a single replica
+const qualifyingWindowLock = "qualifying_windows"
+
 // NewApp creates a new application instance
 func NewApp(cfg *config.Config) (*App, error) {
 	app := &App{
@@ -85,6 +112,11 @@ func (a *App) Start() error {
 func (a *App) Shutdown(ctx context.Context) error {
 	log.Println("Shutting down server...")
 
+	// Stop background workers
+	if a.stopWorkers != nil {
+		a.stopWorkers()
+	}
+
 	// Close gRPC connections
 	if a.authenticationService != nil {
 		log.Println("Closing authentication service connection...")
@@ -96,6 +128,14 @@ func (a *App) Shutdown(ctx context.Context) error {
 		a.authorizationService.Close()
 	}
 
+	// Close broker
+	if a.broker != nil {
+		log.Println("Closing event broker...")
+		if err := a.broker.Close(); err != nil {
+			log.Printf("failed to close event broker: %v", err)
+		}
+	}
+
 	// Close database connection
 	if a.db != nil {
 		log.Println("Closing database connection...")
@@ -106,20 +146,35 @@ func (a *App) Shutdown(ctx context.Context) error {
 	return a.server.Shutdown(ctx)
 }
 
+// newBroker creates the event broker of the configured backend
+func (a *App) newBroker() (event.Broker, error) {
+	switch a.config.Broker.Backend {
+	case config.BrokerMemory:
+		return event.NewMemoryBroker(), nil
+	case config.BrokerPostgres:
+		return event.NewPostgresBroker(a.db, a.config.Database.ConnectionString())
+	default:
+		return nil, fmt.Errorf("unknown event broker %q", a.config.Broker.Backend)
+	}
+}
+
 // initializeDependencies initializes all dependencies
 func (a *App) initializeDependencies() error {
 	var err error
 
-	// Initialize and start broker
-	a.broker = event.NewBroker()
-	a.broker.Start()
-
 	// Initialize database
 	a.db, err = a.config.Database.NewDB()
 	if err != nil {
 		return fmt.Errorf("failed to initialize database: %w", err)
 	}
 
+	// Initialize and start broker
+	a.broker, err = a.newBroker()
+	if err != nil {
+		return fmt.Errorf("failed to initialize broker: %w", err)
+	}
+	a.broker.Start()
+
 	// Initialize repositories
 	a.tournamentRepository, err = postgres.NewTournamentRepository(a.db)
 	if err != nil {
@@ -141,11 +196,54 @@ func (a *App) initializeDependencies() error {
 		return fmt.Errorf("failed to initialize qualifying repository: %w", err)
 	}
 
+	a.matchRepository, err = postgres.NewMatchRepository(a.db)
+	if err != nil {
+		return fmt.Errorf("failed to initialize match repository: %w", err)
+	}
+
+	a.scheduleRepository, err = postgres.NewScheduleRepository(a.db)
+	if err != nil {
+		return fmt.Errorf("failed to initialize schedule repository: %w", err)
+	}
+
+	a.stationRepository, err = postgres.NewStationRepository(a.db)
+	if err != nil {
+		return fmt.Errorf("failed to initialize station repository: %w", err)
+	}
+
+	a.outboxRepository, err = postgres.NewOutboxRepository(a.db)
+	if err != nil {
+		return fmt.Errorf("failed to initialize outbox repository: %w", err)
+	}
+
+	a.transactionManager, err = postgres.NewTransactionManager(a.db)
+	if err != nil {
+		return fmt.Errorf("failed to initialize transaction manager: %w", err)
+	}
+
+	a.leaderLock, err = postgres.NewLeaderLock(a.db)
+	if err != nil {
+		return fmt.Errorf("failed to initialize leader lock: %w", err)
+	}
+
 	// Initialize services
-	a.tournamentService = service.NewTournamentService(a.tournamentRepository, a.broker)
+	a.tournamentService = service.NewTournamentService(a.tournamentRepository, a.qualifyingRepository, a.stationRepository, a.outboxRepository, a.transactionManager)
 	a.userService = service.NewUserService(a.userRepository)
 	a.playerService = service.NewPlayerService(a.playerRepository)
-	a.qualifyingService = service.NewQualifyingService(a.qualifyingRepository)
+	a.qualifyingService = service.NewQualifyingService(a.tournamentRepository, a.qualifyingRepository, a.playerRepository, a.outboxRepository, a.transactionManager)
+	a.matchService = service.NewMatchService(a.tournamentRepository, a.matchRepository, a.qualifyingRepository, a.stationRepository, a.outboxRepository, a.transactionManager)
+	a.standingsService = service.NewStandingsService(a.tournamentRepository, a.qualifyingRepository)
+	a.scheduleService = service.NewScheduleService(a.tournamentRepository, a.scheduleRepository, a.stationRepository, a.outboxRepository, a.transactionManager)
+	a.stationService = service.NewStationService(a.tournamentRepository, a.stationRepository, a.outboxRepository, a.transactionManager)
+	a.outboxService = service.NewOutboxService(a.outboxRepository, a.broker, a.transactionManager)
+
+	// Start background workers
+	var workerCtx context.Context
+	workerCtx, a.stopWorkers = context.WithCancel(context.Background())
+	go a.leaderLock.RunAsLeader(workerCtx, qualifyingWindowLock, leaderLockInterval, func(ctx context.Context) {
+		a.qualifyingService.WatchQualifyingWindows(ctx, qualifyingWindowInterval)
+	})
+	go a.outboxService.DispatchEvents(workerCtx, outboxDispatchInterval)
 
 	// Initialize gRPC client services
 	a.authenticationService, err = service.NewAuthenticationService(a.config.GRPC.IdentityServiceAddr)
@@ -159,7 +257,7 @@ func (a *App) initializeDependencies() error {
 	}
 
 	// Initialize handlers
-	a.tournamentHandler = handler.NewTournamentHandler(a.tournamentService, a.playerService, a.qualifyingService)
+	a.tournamentHandler = handler.NewTournamentHandler(a.tournamentService, a.playerService, a.qualifyingService, a.matchService, a.standingsService, a.scheduleService, a.stationService)
 	a.eventHandler = handler.NewEventHandler(a.broker)
 
 	return nil
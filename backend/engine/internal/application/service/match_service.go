@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"engine/internal/domain"
+	"engine/internal/ports/input"
+	"engine/internal/ports/output"
+	"sort"
+)
+
+// MatchService implements the MatchServiceInterface
+type MatchService struct {
+	tournamentRepository output.TournamentRepositoryInterface
+	matchRepository      output.MatchRepositoryInterface
+	qualifyingRepository output.QualifyingRepositoryInterface
+	stationRepository    output.StationRepositoryInterface
+	outboxRepository     output.OutboxRepositoryInterface
+	transactionManager   output.TransactionManagerInterface
+}
+
+// NewMatchService creates a new match service
+func NewMatchService(
+	tournamentRepository output.TournamentRepositoryInterface,
+	matchRepository output.MatchRepositoryInterface,
+	qualifyingRepository output.QualifyingRepositoryInterface,
+	stationRepository output.StationRepositoryInterface,
+	outboxRepository output.OutboxRepositoryInterface,
+	transactionManager output.TransactionManagerInterface,
+) input.MatchServiceInterface {
+	return &MatchService{
+		tournamentRepository: tournamentRepository,
+		matchRepository:      matchRepository,
+		qualifyingRepository: qualifyingRepository,
+		stationRepository:    stationRepository,
+		outboxRepository:     outboxRepository,
+		transactionManager:   transactionManager,
+	}
+}
+
+// RecordPlacements validates and stores the full finishing order of a match.
+// Completing the last match of a round advances the top players into the next round.
+// Once the next round is drawn, the results of the completed round can no longer be changed.
+// The placements and everything they advance are stored in one transaction that holds the lock of the tournament,
+// so concurrent results are applied one after another and a failed advancement leaves the match unrecorded.
+func (s *MatchService) RecordPlacements(ctx context.Context, tournamentId string, roundId string, groupId string, matchId string, placements []domain.Placement) (match *domain.Match) {
+	err := s.transactionManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		match = s.recordPlacements(ctx, tournamentId, roundId, groupId, matchId, placements)
+		return nil
+	})
+	s.handleError(err)
+	return match
+}
+
+// SetMapName records the map a match is played on. The map can be changed until placements are recorded.
+func (s *MatchService) SetMapName(ctx context.Context, tournamentId string, roundId string, groupId string, matchId string, mapName string) (match *domain.Match) {
+	err := s.transactionManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		match = s.setMapName(ctx, tournamentId, roundId, groupId, matchId, mapName)
+		return nil
+	})
+	s.handleError(err)
+	return match
+}
+
+// recordPlacements stores the placements of a match within the running transaction
+func (s *MatchService) recordPlacements(ctx context.Context, tournamentId string, roundId string, groupId string, matchId string, placements []domain.Placement) *domain.Match {
+	tournament, err := s.tournamentRepository.FindByIDForUpdate(ctx, tournamentId)
+	s.handleError(err)
+
+	if tournament.Status == domain.StatusPaused {
+		panic(domain.NewNotAllowedError("Tournament is paused."))
+	}
+	if tournament.Status != domain.StatusActive {
+		panic(domain.NewNotAllowedError("Placements can only be recorded while the tournament is active."))
+	}
+
+	round, err := tournament.FindRound(roundId)
+	s.handleError(err)
+
+	group, err := round.FindGroup(groupId)
+	s.handleError(err)
+
+	match, err := group.FindMatch(matchId)
+	s.handleError(err)
+
+	err = tournament.CheckRoundOpen(round)
+	s.handleError(err)
+
+	err = round.CheckBracketMatchReady(match)
+	s.handleError(err)
+
+	err = group.ValidatePlacements(match, placements)
+	s.handleError(err)
+
+	sort.Slice(placements, func(i, j int) bool {
+		return placements[i].Placement < placements[j].Placement
+	})
+
+	match.Placements, err = s.matchRepository.ReplacePlacements(ctx, match.Id, placements)
+	s.handleError(err)
+
+	// A copy is recorded, as advancing a bracket reorders the matches of the group
+	tournament.RecordEvent("match."+match.Id+".placements", *match)
+
+	if round.NeedsNextSwissStage(group) {
+		s.pairNextSwissStage(ctx, tournament, round, group)
+	}
+
+	if round.Type.IsElimination() {
+		s.advanceBracket(ctx, tournament, round, group)
+	}
+
+	if round.IsComplete() {
+		s.advanceRound(ctx, tournament, round)
+	}
+
+	err = allocateStations(ctx, s.stationRepository, tournament)
+	s.handleError(err)
+
+	err = s.outboxRepository.InsertEvents(ctx, tournament)
+	s.handleError(err)
+
+	return match
+}
+
+// setMapName stores the map of a match within the running transaction
+func (s *MatchService) setMapName(ctx context.Context, tournamentId string, roundId string, groupId string, matchId string, mapName string) *domain.Match {
+	tournament, err := s.tournamentRepository.FindByIDForUpdate(ctx, tournamentId)
+	s.handleError(err)
+
+	if !tournament.Status.IsRunning() {
+		panic(domain.NewNotAllowedError("Maps can only be changed while the tournament is running."))
+	}
+
+	round, err := tournament.FindRound(roundId)
+	s.handleError(err)
+
+	group, err := round.FindGroup(groupId)
+	s.handleError(err)
+
+	match, err := group.FindMatch(matchId)
+	s.handleError(err)
+
+	err = tournament.MapPoolFor(round).ValidateMapPick(match, mapName)
+	s.handleError(err)
+
+	err = s.matchRepository.UpdateMapName(ctx, match.Id, mapName)
+	s.handleError(err)
+	match.MapName = mapName
+
+	tournament.RecordEvent("match."+match.Id+".map", match)
+	err = s.outboxRepository.InsertEvents(ctx, tournament)
+	s.handleError(err)
+	return match
+}
+
+// pairNextSwissStage pairs the players of a Swiss pool by their cumulative points and persists the new matches
+func (s *MatchService) pairNextSwissStage(ctx context.Context, tournament *domain.Tournament, round *domain.Round, pool *domain.Group) {
+	matches := domain.PairSwissStage(round, pool, tournament.PointsSchemeFor(round))
+	tournament.MapPoolFor(round).AssignMaps(pool, matches)
+
+	matches, err := s.matchRepository.InsertMatches(ctx, pool.Id, matches)
+	s.handleError(err)
+	pool.Matches = append(pool.Matches, matches...)
+
+	tournament.RecordEvent("round."+round.Id+".swiss.paired", pool)
+}
+
+// advanceBracket moves the winner and loser of a decided bracket match into their successor matches
+func (s *MatchService) advanceBracket(ctx context.Context, tournament *domain.Tournament, round *domain.Round, bracket *domain.Group) {
+	changed, added := bracket.AdvanceBracket(round)
+	tournament.MapPoolFor(round).AssignSeatedMaps(bracket, changed)
+
+	err := s.matchRepository.SaveProgress(ctx, changed)
+	s.handleError(err)
+
+	if len(added) > 0 {
+		tournament.MapPoolFor(round).AssignMaps(bracket, added)
+		added, err = s.matchRepository.InsertMatches(ctx, bracket.Id, added)
+		s.handleError(err)
+		bracket.Matches = append(bracket.Matches, added...)
+	}
+
+	tournament.RecordEvent("round."+round.Id+".bracket.advanced", bracket)
+}
+
+// advanceRound moves the top players of a completed round into the next round
+// or completes the tournament if the round was the last one
+func (s *MatchService) advanceRound(ctx context.Context, tournament *domain.Tournament, round *domain.Round) {
+	nextRound := tournament.NextRound(round)
+	if nextRound != nil && len(nextRound.Groups) > 0 {
+		return
+	}
+
+	tournament.RecordEvent("round."+round.Id+".completed", round)
+
+	if nextRound == nil {
+		err := tournament.TransitionTo(domain.StatusCompleted)
+		s.handleError(err)
+		tournament.RecordEvent("completed", tournament)
+
+		_, err = s.tournamentRepository.Update(ctx, tournament)
+		s.handleError(err)
+		return
+	}
+
+	players := s.advancingPlayers(ctx, tournament, round)
+	groups, err := domain.DrawGroups(nextRound, players, tournament.SeedingStrategy, tournament.SeedingSeed, tournament.AllowUnderfilledGroups)
+	s.handleError(err)
+	tournament.MapPoolFor(nextRound).AssignGroupMaps(groups)
+	nextRound.Groups = groups
+	tournament.RecordEvent("round."+nextRound.Id+".started", nextRound)
+
+	_, err = s.tournamentRepository.StartRound(ctx, tournament, nextRound)
+	s.handleError(err)
+}
+
+// advancingPlayers returns the players advancing from a completed round ordered by their result.
+// Elimination rounds are decided by the bracket, all other rounds by their standings.
+func (s *MatchService) advancingPlayers(ctx context.Context, tournament *domain.Tournament, round *domain.Round) []domain.Player {
+	if round.Type.IsElimination() {
+		return domain.BracketAdvancingPlayers(round, tournament.Id)
+	}
+
+	qualifyingTimes, err := findQualifyingTimes(ctx, s.qualifyingRepository, tournament.Id)
+	s.handleError(err)
+
+	tieBreakers := domain.TieBreakersFor(round, qualifyingTimes)
+	standings := make([]*domain.GroupStandings, 0, len(round.Groups))
+	for i := range round.Groups {
+		standings = append(standings, domain.CalculateStandings(&round.Groups[i], tournament.PointsSchemeFor(round), tieBreakers))
+	}
+
+	return domain.AdvancingPlayers(round, standings, tieBreakers, tournament.Id)
+}
+
+// handleError handles repository and domain errors consistently
+func (s *MatchService) handleError(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
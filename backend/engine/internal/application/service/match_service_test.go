@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"engine/internal/domain"
+	"engine/internal/ports/output"
+	"testing"
+)
+
+// MockTournamentRepository is a mock implementation of the TournamentRepositoryInterface.
+// Methods that are not overridden panic when called.
+type MockTournamentRepository struct {
+	output.TournamentRepositoryInterface
+	tournament    *domain.Tournament
+	startedRounds []*domain.Round
+}
+
+// FindByIDForUpdate mocks loading and locking the tournament
+func (m *MockTournamentRepository) FindByIDForUpdate(ctx context.Context, id string) (*domain.Tournament, error) {
+	if m.tournament == nil || m.tournament.Id != id {
+		return nil, domain.NewNotFoundError("tournament not found")
+	}
+	return m.tournament, nil
+}
+
+// StartRound mocks persisting the drawn groups of a round
+func (m *MockTournamentRepository) StartRound(ctx context.Context, tournament *domain.Tournament, round *domain.Round) (*domain.Tournament, error) {
+	m.startedRounds = append(m.startedRounds, round)
+	return tournament, nil
+}
+
+// MockQualifyingRepository is a mock implementation of the QualifyingRepositoryInterface.
+// Methods that are not overridden panic when called.
+type MockQualifyingRepository struct {
+	output.QualifyingRepositoryInterface
+	qualifying *domain.Qualifying
+}
+
+// FindByTournamentId mocks returning the qualifying of a tournament
+func (m *MockQualifyingRepository) FindByTournamentId(ctx context.Context, id string) (*domain.Qualifying, error) {
+	return m.qualifying, nil
+}
+
+// decideBracketMatch records the winner of the bracket match at the given position and advances the bracket
+func decideBracketMatch(t *testing.T, round *domain.Round, bracket *domain.Group, position int, winnerId string) {
+	t.Helper()
+	for i := range bracket.Matches {
+		match := &bracket.Matches[i]
+		if match.Position != position {
+			continue
+		}
+		for _, player := range match.Players {
+			placement := 2
+			if player.Id == winnerId {
+				placement = 1
+			}
+			match.Placements = append(match.Placements, domain.Placement{PlayerId: player.Id, Placement: placement})
+		}
+		_, added := bracket.AdvanceBracket(round)
+		bracket.Matches = append(bracket.Matches, added...)
+		return
+	}
+	t.Fatalf("Expected a match at position %d", position)
+}
+
+func TestAdvanceRoundSeedsEliminationPlayersByBracketResult(t *testing.T) {
+	tournament := &domain.Tournament{
+		Id:           "t1",
+		Status:       domain.StatusActive,
+		PointsScheme: domain.DefaultPointsScheme,
+		Rounds: []domain.Round{
+			{Id: "r1", Name: "Bracket", Type: domain.RoundTypeDoubleElimination, PlayerCount: 4, GroupSize: 2, PlayerAdvancementCount: 1, GrandFinalReset: true},
+			{Id: "r2", Name: "Final", Type: domain.RoundTypeSingleElimination, PlayerCount: 2, GroupSize: 2, PlayerAdvancementCount: 1},
+		},
+	}
+	round := &tournament.Rounds[0]
+	players := []domain.Player{{Id: "1"}, {Id: "2"}, {Id: "3"}, {Id: "4"}}
+	groups, err := domain.DrawGroups(round, players, domain.SeedingSnake, 0, false)
+	if err != nil {
+		t.Fatalf("Expected the bracket to be drawn, got %v", err)
+	}
+	round.Groups = groups
+	bracket := &round.Groups[0]
+
+	// Player 3 reaches the grand final through the losers bracket and forces a reset, so it collects
+	// more points than champion 1, who only wins the reset
+	decideBracketMatch(t, round, bracket, 0, "1")
+	decideBracketMatch(t, round, bracket, 1, "2")
+	decideBracketMatch(t, round, bracket, 2, "1")
+	decideBracketMatch(t, round, bracket, 3, "3")
+	decideBracketMatch(t, round, bracket, 4, "3")
+	decideBracketMatch(t, round, bracket, 5, "3")
+	decideBracketMatch(t, round, bracket, 6, "1")
+	if !round.IsComplete() {
+		t.Fatal("Expected the bracket to be complete")
+	}
+
+	tournamentRepository := &MockTournamentRepository{}
+	service := &MatchService{
+		tournamentRepository: tournamentRepository,
+		qualifyingRepository: &MockQualifyingRepository{qualifying: &domain.Qualifying{TournamentId: "t1"}},
+	}
+	service.advanceRound(context.Background(), tournament, round)
+
+	if len(tournamentRepository.startedRounds) != 1 {
+		t.Fatalf("Expected the next round to be started, got %d started rounds", len(tournamentRepository.startedRounds))
+	}
+	advanced := tournament.Rounds[1].Groups[0].Players
+	if len(advanced) != 2 || advanced[0].Id != "1" || advanced[1].Id != "3" {
+		t.Errorf("Expected champion 1 to be seeded ahead of runner-up 3, got %+v", advanced)
+	}
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"engine/internal/adapters/driven/event"
+	"engine/internal/ports/input"
+	"engine/internal/ports/output"
+	"log"
+	"strings"
+	"time"
+)
+
+// outboxBatchSize is the maximum number of events published per dispatch
+const outboxBatchSize = 100
+
+// OutboxService publishes the events written to the outbox together with the changes that caused them
+type OutboxService struct {
+	outboxRepository   output.OutboxRepositoryInterface
+	eventBroker        event.Broker
+	transactionManager output.TransactionManagerInterface
+}
+
+// NewOutboxService creates a new outbox service
+func NewOutboxService(
+	outboxRepository output.OutboxRepositoryInterface,
+	eventBroker event.Broker,
+	transactionManager output.TransactionManagerInterface,
+) input.OutboxServiceInterface {
+	return &OutboxService{
+		outboxRepository:   outboxRepository,
+		eventBroker:        eventBroker,
+		transactionManager: transactionManager,
+	}
+}
+
+// DispatchEvents publishes pending outbox events to the broker until the context is cancelled.
+// Events are marked as dispatched only after they have been published, so an event is published
+// again if marking fails or the process stops in between (at-least-once delivery).
+func (s *OutboxService) DispatchEvents(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.dispatchPending(ctx); err != nil {
+				log.Printf("failed to dispatch outbox events: %v", err)
+			}
+		}
+	}
+}
+
+// dispatchPending publishes batches of pending events until the outbox is drained
+func (s *OutboxService) dispatchPending(ctx context.Context) error {
+	for {
+		drained := false
+		var publishErr error
+		err := s.transactionManager.WithinTransaction(ctx, func(ctx context.Context) error {
+			var err error
+			drained, publishErr, err = s.dispatchBatch(ctx)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		if publishErr != nil {
+			return publishErr
+		}
+		if drained {
+			return nil
+		}
+	}
+}
+
+// dispatchBatch publishes a batch of pending events and marks them as dispatched within the running transaction.
+// The events stay claimed until the transaction ends, so replicas dispatching at the same time skip them.
+// It reports whether the outbox was drained and returns the error of a failed publish separately,
+// so the transaction is still committed for the events published before it.
+func (s *OutboxService) dispatchBatch(ctx context.Context) (drained bool, publishErr error, err error) {
+	events, err := s.outboxRepository.FindPending(ctx, outboxBatchSize)
+	if err != nil {
+		return false, nil, err
+	}
+	if len(events) == 0 {
+		return true, nil, nil
+	}
+
+	ids := make([]int64, 0, len(events))
+	for _, outboxEvent := range events {
+		topic := event.TournamentTopic(outboxEvent.TournamentId, strings.Split(outboxEvent.Name, ".")...)
+		if publishErr = s.eventBroker.Publish(topic, outboxEvent.Payload); publishErr != nil {
+			// Later events stay pending so they are not published before this one
+			break
+		}
+		ids = append(ids, outboxEvent.Id)
+	}
+
+	if len(ids) > 0 {
+		if err = s.outboxRepository.MarkDispatched(ctx, ids); err != nil {
+			return false, nil, err
+		}
+	}
+	return len(events) < outboxBatchSize, publishErr, nil
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"engine/internal/adapters/driven/event"
+	"engine/internal/domain"
+	"errors"
+	"testing"
+	"time"
+)
+
+// MockOutboxRepository is a mock implementation of the OutboxRepositoryInterface
+type MockOutboxRepository struct {
+	events []domain.OutboxEvent
+	// Control error responses for testing error paths
+	shouldFailMarking bool
+}
+
+// MockTransactionManager is a mock implementation of the TransactionManagerInterface
+type MockTransactionManager struct{}
+
+// WithinTransaction mocks running fn in a transaction
+func (m *MockTransactionManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// InsertEvents mocks writing the recorded events of a tournament to the outbox
+func (m *MockOutboxRepository) InsertEvents(ctx context.Context, tournament *domain.Tournament) error {
+	for _, recorded := range tournament.Events {
+		payload, err := json.Marshal(recorded.Payload)
+		if err != nil {
+			return err
+		}
+		m.events = append(m.events, domain.OutboxEvent{Id: int64(len(m.events) + 1), TournamentId: tournament.Id, Name: recorded.Name, Payload: payload})
+	}
+	tournament.Events = nil
+	return nil
+}
+
+// FindPending mocks returning the events that have not been dispatched yet
+func (m *MockOutboxRepository) FindPending(ctx context.Context, limit int) ([]domain.OutboxEvent, error) {
+	pending := make([]domain.OutboxEvent, 0)
+	for _, outboxEvent := range m.events {
+		if outboxEvent.DispatchedAt == nil && len(pending) < limit {
+			pending = append(pending, outboxEvent)
+		}
+	}
+	return pending, nil
+}
+
+// MarkDispatched mocks marking events as dispatched
+func (m *MockOutboxRepository) MarkDispatched(ctx context.Context, ids []int64) error {
+	if m.shouldFailMarking {
+		return errors.New("mock mark error")
+	}
+
+	now := time.Now()
+	for i := range m.events {
+		for _, id := range ids {
+			if m.events[i].Id == id {
+				m.events[i].DispatchedAt = &now
+			}
+		}
+	}
+	return nil
+}
+
+func TestOutboxServiceRedeliversUnmarkedEvents(t *testing.T) {
+	broker := event.NewMemoryBroker()
+	broker.Start()
+	client := event.NewClient()
+	client.SubscribeTo("#")
+	broker.Register(client)
+
+	repository := &MockOutboxRepository{
+		events: []domain.OutboxEvent{
+			{Id: 1, TournamentId: "t1", Name: "created", Payload: json.RawMessage(`{"id":"t1"}`)},
+			{Id: 2, TournamentId: "t1", Name: "qualifying.opened", Payload: json.RawMessage(`{"open":true}`)},
+		},
+		shouldFailMarking: true,
+	}
+	service := &OutboxService{outboxRepository: repository, eventBroker: broker, transactionManager: &MockTransactionManager{}}
+
+	if err := service.dispatchPending(context.Background()); err == nil {
+		t.Fatal("Expected an error when marking fails")
+	}
+	receiveTopic(t, client, "tournament.t1.created")
+	receiveTopic(t, client, "tournament.t1.qualifying.opened")
+
+	// The events were not marked, so they are published again
+	repository.shouldFailMarking = false
+	if err := service.dispatchPending(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	receiveTopic(t, client, "tournament.t1.created")
+	receiveTopic(t, client, "tournament.t1.qualifying.opened")
+
+	pending, _ := repository.FindPending(context.Background(), outboxBatchSize)
+	if len(pending) != 0 {
+		t.Errorf("Expected all events to be dispatched, got %d pending", len(pending))
+	}
+}
+
+func receiveTopic(t *testing.T, client *event.Client, topic string) {
+	t.Helper()
+	select {
+	case data := <-client.Send:
+		var message event.Message
+		if err := json.Unmarshal(data, &message); err != nil {
+			t.Fatalf("Failed to decode message: %v", err)
+		}
+		if message.Topic != topic {
+			t.Errorf("Expected topic %s, got %s", topic, message.Topic)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Expected a message on %s", topic)
+	}
+}
@@ -5,15 +5,32 @@ import (
 	"engine/internal/domain"
 	"engine/internal/ports/input"
 	"engine/internal/ports/output"
+	"log"
+	"sort"
+	"time"
 )
 
 type QualifyingService struct {
+	tournamentRepository output.TournamentRepositoryInterface
 	qualifyingRepository output.QualifyingRepositoryInterface
+	playerRepository     output.PlayerRepositoryInterface
+	outboxRepository     output.OutboxRepositoryInterface
+	transactionManager   output.TransactionManagerInterface
 }
 
-func NewQualifyingService(qualifyingRepository output.QualifyingRepositoryInterface) input.QualifyingServiceInterface {
+func NewQualifyingService(
+	tournamentRepository output.TournamentRepositoryInterface,
+	qualifyingRepository output.QualifyingRepositoryInterface,
+	playerRepository output.PlayerRepositoryInterface,
+	outboxRepository output.OutboxRepositoryInterface,
+	transactionManager output.TransactionManagerInterface,
+) input.QualifyingServiceInterface {
 	return &QualifyingService{
+		tournamentRepository: tournamentRepository,
 		qualifyingRepository: qualifyingRepository,
+		playerRepository:     playerRepository,
+		outboxRepository:     outboxRepository,
+		transactionManager:   transactionManager,
 	}
 }
 
@@ -30,10 +47,297 @@ func (q QualifyingService) DeleteQualifyingByTournamentId(ctx context.Context, i
 	panic("implement me")
 }
 
-func (q QualifyingService) AddPlayerToQualifying(ctx context.Context, tournamentId string, playerId string) {
-	err := q.qualifyingRepository.AddPlayer(ctx, tournamentId, playerId)
+// SignUpPlayer registers a new player and adds them to the qualifying.
+// Signups are rejected once qualifying is closed, as the main event players are determined then.
+func (q QualifyingService) SignUpPlayer(ctx context.Context, tournamentId string, name string) (player *domain.Player) {
+	err := q.transactionManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		tournament, err := q.tournamentRepository.FindByIDForUpdate(ctx, tournamentId)
+		if err != nil {
+			return err
+		}
 
+		if err = tournament.CheckSignupAllowed(); err != nil {
+			return err
+		}
+
+		player, err = q.playerRepository.InsertNewPlayer(ctx, &domain.Player{Name: name, TournamentId: tournamentId})
+		if err != nil {
+			return err
+		}
+
+		return q.qualifyingRepository.AddPlayer(ctx, tournamentId, player.Id)
+	})
+	if err != nil {
+		panic(err)
+	}
+	return player
+}
+
+// WithdrawPlayer removes a player from the tournament. A withdrawal after qualifying was closed frees a spot
+// in the main event for the best ranked waitlisted player, who is promoted in the same transaction.
+func (q QualifyingService) WithdrawPlayer(ctx context.Context, tournamentId string, playerId string) {
+	err := q.transactionManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		tournament, err := q.tournamentRepository.FindByIDForUpdate(ctx, tournamentId)
+		if err != nil {
+			return err
+		}
+
+		if err = tournament.WithdrawPlayer(playerId); err != nil {
+			return err
+		}
+
+		if err = q.playerRepository.Delete(ctx, playerId); err != nil {
+			return err
+		}
+
+		q.promoteWaitlistedPlayer(ctx, tournament)
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// SubmitQualifyingTime stores a qualifying attempt of a player. The tournament is locked while the attempt limit is
+// checked, so concurrent submissions of a player cannot exceed it.
+func (q QualifyingService) SubmitQualifyingTime(ctx context.Context, tournamentId string, playerId string, milliseconds int) (player *domain.QualifyingPlayer) {
+	err := q.transactionManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		player = q.submitQualifyingTime(ctx, tournamentId, playerId, milliseconds)
+		return nil
+	})
 	if err != nil {
 		panic(err)
 	}
+	return player
+}
+
+// submitQualifyingTime stores a qualifying attempt within the running transaction
+func (q QualifyingService) submitQualifyingTime(ctx context.Context, tournamentId string, playerId string, milliseconds int) *domain.QualifyingPlayer {
+	tournament, err := q.tournamentRepository.FindByIDForUpdate(ctx, tournamentId)
+	if err != nil {
+		panic(err)
+	}
+
+	attempts, err := q.qualifyingRepository.FindAttempts(ctx, tournamentId, playerId)
+	if err != nil {
+		panic(err)
+	}
+
+	if err = tournament.Qualifying.CheckSubmissionAllowed(time.Now()); err != nil {
+		panic(err)
+	}
+
+	if err = tournament.Qualifying.CheckAttemptAllowed(len(attempts)); err != nil {
+		panic(err)
+	}
+
+	_, err = q.qualifyingRepository.AddAttempt(ctx, &domain.QualifyingAttempt{
+		TournamentId: tournamentId,
+		PlayerId:     playerId,
+		Time:         milliseconds,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	qualifying := q.GetQualifyingByTournamentId(ctx, tournamentId)
+	player, err := qualifying.FindPlayer(playerId)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return player
+}
+
+func (q QualifyingService) ListQualifyingAttempts(ctx context.Context, tournamentId string, playerId string) []*domain.QualifyingAttempt {
+	attempts, err := q.qualifyingRepository.FindAttempts(ctx, tournamentId, playerId)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return attempts
+}
+
+// CloseQualifying takes the best ranked players into the main event and marks the rest with the overflow status
+func (q QualifyingService) CloseQualifying(ctx context.Context, tournamentId string, overflow domain.PlayerStatus) (tournament *domain.Tournament) {
+	err := q.transactionManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		tournament = q.closeQualifying(ctx, tournamentId, overflow)
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return tournament
+}
+
+// closeQualifying determines the main event players within the running transaction
+func (q QualifyingService) closeQualifying(ctx context.Context, tournamentId string, overflow domain.PlayerStatus) *domain.Tournament {
+	tournament, err := q.tournamentRepository.FindByIDForUpdate(ctx, tournamentId)
+	if err != nil {
+		panic(err)
+	}
+
+	qualifying, err := q.qualifyingRepository.FindByTournamentId(ctx, tournamentId)
+	if err != nil {
+		panic(err)
+	}
+
+	err = tournament.CloseQualifying(rankPlayersByQualifying(qualifying, tournament.Players), overflow, time.Now())
+	if err != nil {
+		panic(err)
+	}
+	tournament.RecordEvent("qualifying.closed", tournament.QualifyingWindow(time.Now()))
+
+	tournament, err = q.tournamentRepository.UpdateQualifyingResult(ctx, tournament)
+	if err != nil {
+		panic(err)
+	}
+
+	return tournament
+}
+
+// OpenQualifying opens the qualifying window before its scheduled opening time
+func (q QualifyingService) OpenQualifying(ctx context.Context, tournamentId string) (tournament *domain.Tournament) {
+	err := q.transactionManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		tournament, err = q.tournamentRepository.FindByIDForUpdate(ctx, tournamentId)
+		if err != nil {
+			return err
+		}
+
+		if err = tournament.OpenQualifying(time.Now()); err != nil {
+			return err
+		}
+		tournament.RecordEvent("qualifying.opened", tournament.QualifyingWindow(time.Now()))
+
+		tournament, err = q.tournamentRepository.UpdateQualifyingWindow(ctx, tournament)
+		return err
+	})
+	if err != nil {
+		panic(err)
+	}
+	return tournament
+}
+
+// WatchQualifyingWindows publishes an event whenever a scheduled qualifying window opens or closes.
+// It blocks until the context is cancelled.
+func (q QualifyingService) WatchQualifyingWindows(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			err := q.transactionManager.WithinTransaction(ctx, func(ctx context.Context) error {
+				return q.publishWindowChanges(ctx, now.UTC())
+			})
+			if err != nil {
+				log.Printf("failed to publish qualifying window changes: %v", err)
+			}
+		}
+	}
+}
+
+// publishWindowChanges records an event for every window that opened or closed since the stored watermark
+// and moves the watermark forward. The watermark is saved in the same transaction as the events,
+// so changes that happen while the engine is down are announced once it is running again.
+func (q QualifyingService) publishWindowChanges(ctx context.Context, to time.Time) error {
+	from, err := q.qualifyingRepository.LockWindowWatermark(ctx, to)
+	if err != nil {
+		return err
+	}
+	if !to.After(from) {
+		return nil
+	}
+
+	tournamentIds, err := q.qualifyingRepository.FindWindowChanges(ctx, from, to)
+	if err != nil {
+		return err
+	}
+
+	for _, tournamentId := range tournamentIds {
+		tournament, err := q.tournamentRepository.FindByID(ctx, tournamentId)
+		if err != nil {
+			return err
+		}
+
+		window := tournament.QualifyingWindow(to)
+		if window.Open {
+			tournament.RecordEvent("qualifying.opened", window)
+		} else {
+			tournament.RecordEvent("qualifying.closed", window)
+		}
+
+		if err = q.outboxRepository.InsertEvents(ctx, tournament); err != nil {
+			return err
+		}
+	}
+
+	return q.qualifyingRepository.SaveWindowWatermark(ctx, to)
+}
+
+// promoteWaitlistedPlayer moves the best ranked waitlisted player into the main event if a spot became free
+func (q QualifyingService) promoteWaitlistedPlayer(ctx context.Context, tournament *domain.Tournament) {
+	qualifying, err := q.qualifyingRepository.FindByTournamentId(ctx, tournament.Id)
+	if err != nil {
+		panic(err)
+	}
+
+	if tournament.PromoteWaitlistedPlayer(rankPlayersByQualifying(qualifying, tournament.Players)) == nil {
+		return
+	}
+
+	_, err = q.tournamentRepository.UpdateQualifyingResult(ctx, tournament)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// rankPlayersByQualifying orders the given players by their qualifying position.
+// Players without a qualifying entry keep their signup order behind the ranked players.
+func rankPlayersByQualifying(qualifying *domain.Qualifying, tournamentPlayers []domain.Player) []domain.Player {
+	qualifyingPlayers := append(make([]*domain.QualifyingPlayer, 0, len(qualifying.Players)), qualifying.Players...)
+	sort.SliceStable(qualifyingPlayers, func(i, j int) bool {
+		return qualifyingPlayers[i].Position < qualifyingPlayers[j].Position
+	})
+
+	playersById := make(map[string]domain.Player, len(tournamentPlayers))
+	for _, player := range tournamentPlayers {
+		playersById[player.Id] = player
+	}
+
+	players := make([]domain.Player, 0, len(tournamentPlayers))
+	for _, qualifyingPlayer := range qualifyingPlayers {
+		if player, ok := playersById[qualifyingPlayer.PlayerId]; ok {
+			players = append(players, player)
+			delete(playersById, player.Id)
+		}
+	}
+
+	for _, player := range tournamentPlayers {
+		if _, ok := playersById[player.Id]; ok {
+			players = append(players, player)
+		}
+	}
+
+	return players
+}
+
+// findQualifyingTimes returns the qualifying time of every player of the tournament
+func findQualifyingTimes(ctx context.Context, qualifyingRepository output.QualifyingRepositoryInterface, tournamentId string) (map[string]int, error) {
+	qualifying, err := qualifyingRepository.FindByTournamentId(ctx, tournamentId)
+	if err != nil {
+		return nil, err
+	}
+
+	times := make(map[string]int, len(qualifying.Players))
+	for _, player := range qualifying.Players {
+		times[player.PlayerId] = player.Time
+	}
+	return times, nil
 }
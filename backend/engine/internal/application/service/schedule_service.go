@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"engine/internal/domain"
+	"engine/internal/ports/input"
+	"engine/internal/ports/output"
+)
+
+// ScheduleService implements the ScheduleServiceInterface
+type ScheduleService struct {
+	tournamentRepository output.TournamentRepositoryInterface
+	scheduleRepository   output.ScheduleRepositoryInterface
+	stationRepository    output.StationRepositoryInterface
+	outboxRepository     output.OutboxRepositoryInterface
+	transactionManager   output.TransactionManagerInterface
+}
+
+// NewScheduleService creates a new schedule service
+func NewScheduleService(
+	tournamentRepository output.TournamentRepositoryInterface,
+	scheduleRepository output.ScheduleRepositoryInterface,
+	stationRepository output.StationRepositoryInterface,
+	outboxRepository output.OutboxRepositoryInterface,
+	transactionManager output.TransactionManagerInterface,
+) input.ScheduleServiceInterface {
+	return &ScheduleService{
+		tournamentRepository: tournamentRepository,
+		scheduleRepository:   scheduleRepository,
+		stationRepository:    stationRepository,
+		outboxRepository:     outboxRepository,
+		transactionManager:   transactionManager,
+	}
+}
+
+// GetSchedule lays out the rounds of a tournament in time slots, including all recorded delays
+func (s *ScheduleService) GetSchedule(ctx context.Context, tournamentId string) *domain.Schedule {
+	tournament, err := s.tournamentRepository.FindByID(ctx, tournamentId)
+	s.handleError(err)
+
+	return s.buildSchedule(ctx, tournament)
+}
+
+// GetCalendar returns the calendar events of a tournament, limited to the groups of a player if a player id is given.
+// Tournaments without a schedule only contain the tournament dates and the qualifying window.
+func (s *ScheduleService) GetCalendar(ctx context.Context, tournamentId string, playerId string) []domain.CalendarEvent {
+	tournament, err := s.tournamentRepository.FindByID(ctx, tournamentId)
+	s.handleError(err)
+
+	var schedule *domain.Schedule
+	if tournament.Schedule.IsConfigured() {
+		schedule = s.buildSchedule(ctx, tournament)
+	}
+
+	events, err := tournament.CalendarEvents(schedule, playerId)
+	s.handleError(err)
+	return events
+}
+
+// DelaySlot records a delay of a slot, which shifts the slot and all slots after it
+func (s *ScheduleService) DelaySlot(ctx context.Context, tournamentId string, delay domain.ScheduleDelay) (schedule *domain.Schedule) {
+	err := s.transactionManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		schedule = s.delaySlot(ctx, tournamentId, delay)
+		return nil
+	})
+	s.handleError(err)
+	return schedule
+}
+
+// delaySlot stores a delay and its event within the running transaction
+func (s *ScheduleService) delaySlot(ctx context.Context, tournamentId string, delay domain.ScheduleDelay) *domain.Schedule {
+	tournament, err := s.tournamentRepository.FindByIDForUpdate(ctx, tournamentId)
+	s.handleError(err)
+
+	if !tournament.Status.IsRunning() {
+		panic(domain.NewNotAllowedError("Slots can only be delayed while the tournament is running."))
+	}
+
+	err = tournament.CheckScheduleDelay(delay)
+	s.handleError(err)
+
+	_, err = s.scheduleRepository.AddDelay(ctx, tournament.Id, &delay)
+	s.handleError(err)
+
+	schedule := s.buildSchedule(ctx, tournament)
+	tournament.RecordEvent("schedule.delayed", schedule)
+
+	err = s.outboxRepository.InsertEvents(ctx, tournament)
+	s.handleError(err)
+	return schedule
+}
+
+// buildSchedule loads the delays and stations of a tournament and lays out its schedule
+func (s *ScheduleService) buildSchedule(ctx context.Context, tournament *domain.Tournament) *domain.Schedule {
+	delays, err := s.scheduleRepository.FindDelays(ctx, tournament.Id)
+	s.handleError(err)
+
+	stations, err := s.stationRepository.FindByTournamentId(ctx, tournament.Id)
+	s.handleError(err)
+
+	schedule, err := tournament.BuildSchedule(delays, stations)
+	s.handleError(err)
+	return schedule
+}
+
+// handleError handles repository and domain errors consistently
+func (s *ScheduleService) handleError(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"engine/internal/domain"
+	"engine/internal/ports/input"
+	"engine/internal/ports/output"
+)
+
+// StandingsService implements the StandingsServiceInterface
+type StandingsService struct {
+	tournamentRepository output.TournamentRepositoryInterface
+	qualifyingRepository output.QualifyingRepositoryInterface
+}
+
+// NewStandingsService creates a new standings service
+func NewStandingsService(
+	tournamentRepository output.TournamentRepositoryInterface,
+	qualifyingRepository output.QualifyingRepositoryInterface,
+) input.StandingsServiceInterface {
+	return &StandingsService{
+		tournamentRepository: tournamentRepository,
+		qualifyingRepository: qualifyingRepository,
+	}
+}
+
+// GetGroupStandings sums the points of every player across the matches of a group and applies the round's tie-breakers
+func (s *StandingsService) GetGroupStandings(ctx context.Context, tournamentId string, roundId string, groupId string) *domain.GroupStandings {
+	tournament, err := s.tournamentRepository.FindByID(ctx, tournamentId)
+	s.handleError(err)
+
+	round, err := tournament.FindRound(roundId)
+	s.handleError(err)
+
+	group, err := round.FindGroup(groupId)
+	s.handleError(err)
+
+	qualifyingTimes, err := findQualifyingTimes(ctx, s.qualifyingRepository, tournament.Id)
+	s.handleError(err)
+	return domain.CalculateStandings(group, tournament.PointsSchemeFor(round), domain.TieBreakersFor(round, qualifyingTimes))
+}
+
+// handleError handles repository and domain errors consistently
+func (s *StandingsService) handleError(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
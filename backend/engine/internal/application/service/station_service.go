@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"engine/internal/domain"
+	"engine/internal/ports/input"
+	"engine/internal/ports/output"
+	"time"
+)
+
+// StationService implements the StationServiceInterface
+type StationService struct {
+	tournamentRepository output.TournamentRepositoryInterface
+	stationRepository    output.StationRepositoryInterface
+	outboxRepository     output.OutboxRepositoryInterface
+	transactionManager   output.TransactionManagerInterface
+}
+
+// NewStationService creates a new station service
+func NewStationService(
+	tournamentRepository output.TournamentRepositoryInterface,
+	stationRepository output.StationRepositoryInterface,
+	outboxRepository output.OutboxRepositoryInterface,
+	transactionManager output.TransactionManagerInterface,
+) input.StationServiceInterface {
+	return &StationService{
+		tournamentRepository: tournamentRepository,
+		stationRepository:    stationRepository,
+		outboxRepository:     outboxRepository,
+		transactionManager:   transactionManager,
+	}
+}
+
+// ListStations returns all stations of a tournament ordered by number
+func (s *StationService) ListStations(ctx context.Context, tournamentId string) []domain.Station {
+	stations, err := s.stationRepository.FindByTournamentId(ctx, tournamentId)
+	s.handleError(err)
+	return stations
+}
+
+// CreateStation adds a station to a tournament. A running tournament immediately moves a waiting group to the new station.
+func (s *StationService) CreateStation(ctx context.Context, station *domain.Station) *domain.Station {
+	err := s.transactionManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		tournament, err := s.tournamentRepository.FindByIDForUpdate(ctx, station.TournamentId)
+		s.handleError(err)
+
+		station, err = s.stationRepository.Insert(ctx, station)
+		s.handleError(err)
+
+		if tournament.Status.IsRunning() {
+			err = allocateStations(ctx, s.stationRepository, tournament)
+			s.handleError(err)
+		}
+
+		return s.outboxRepository.InsertEvents(ctx, tournament)
+	})
+	s.handleError(err)
+
+	stations, err := s.stationRepository.FindByTournamentId(ctx, station.TournamentId)
+	s.handleError(err)
+	for i := range stations {
+		if stations[i].Id == station.Id {
+			return &stations[i]
+		}
+	}
+	return station
+}
+
+// DeleteStation removes a station that no group is playing on.
+// The station is checked and deleted under the lock of the tournament, so no group can be moved to it in between.
+func (s *StationService) DeleteStation(ctx context.Context, tournamentId string, stationId string) {
+	err := s.transactionManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		s.deleteStation(ctx, tournamentId, stationId)
+		return nil
+	})
+	s.handleError(err)
+}
+
+// deleteStation removes a station within the running transaction. The stations of finished groups are released
+// first, so only a station that a group is still playing on is rejected.
+func (s *StationService) deleteStation(ctx context.Context, tournamentId string, stationId string) {
+	tournament, err := s.tournamentRepository.FindByIDForUpdate(ctx, tournamentId)
+	s.handleError(err)
+
+	if tournament.Status.IsRunning() {
+		err = allocateStations(ctx, s.stationRepository, tournament)
+		s.handleError(err)
+	}
+
+	stations, err := s.stationRepository.FindByTournamentId(ctx, tournamentId)
+	s.handleError(err)
+
+	for _, station := range stations {
+		if station.Id == stationId && !station.IsFree() {
+			panic(domain.NewConflictError("A group is playing on the station."))
+		}
+	}
+
+	err = s.stationRepository.Delete(ctx, tournamentId, stationId)
+	s.handleError(err)
+
+	err = s.outboxRepository.InsertEvents(ctx, tournament)
+	s.handleError(err)
+}
+
+// handleError handles repository and domain errors consistently
+func (s *StationService) handleError(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+// allocateStations frees the stations of finished groups, moves waiting groups to free stations
+// and records station released and assigned events for every change
+func allocateStations(ctx context.Context, stationRepository output.StationRepositoryInterface, tournament *domain.Tournament) error {
+	stations, err := stationRepository.FindByTournamentId(ctx, tournament.Id)
+	if err != nil {
+		return err
+	}
+
+	assigned, released := tournament.AllocateStations(stations, time.Now())
+	if len(assigned) == 0 && len(released) == 0 {
+		return nil
+	}
+
+	if err = stationRepository.SaveAssignments(ctx, stations); err != nil {
+		return err
+	}
+
+	for _, assignment := range released {
+		tournament.RecordEvent("station."+assignment.StationId+".released", assignment)
+	}
+	for _, assignment := range assigned {
+		tournament.RecordEvent("station."+assignment.StationId+".assigned", assignment)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"engine/internal/domain"
+	"testing"
+)
+
+// MockStationRepository is a mock implementation of the StationRepositoryInterface
+type MockStationRepository struct {
+	stations []domain.Station
+}
+
+// FindByTournamentId mocks returning the stations of a tournament
+func (m *MockStationRepository) FindByTournamentId(ctx context.Context, tournamentId string) ([]domain.Station, error) {
+	return append([]domain.Station(nil), m.stations...), nil
+}
+
+// Insert mocks storing a new station
+func (m *MockStationRepository) Insert(ctx context.Context, station *domain.Station) (*domain.Station, error) {
+	m.stations = append(m.stations, *station)
+	return station, nil
+}
+
+// Delete mocks removing a station
+func (m *MockStationRepository) Delete(ctx context.Context, tournamentId string, stationId string) error {
+	for i := range m.stations {
+		if m.stations[i].Id == stationId {
+			m.stations = append(m.stations[:i], m.stations[i+1:]...)
+			return nil
+		}
+	}
+	return domain.NewNotFoundError("station not found")
+}
+
+// SaveAssignments mocks storing the groups playing on the stations
+func (m *MockStationRepository) SaveAssignments(ctx context.Context, stations []domain.Station) error {
+	m.stations = append([]domain.Station(nil), stations...)
+	return nil
+}
+
+// stationTestService returns a station service for an active tournament whose group-a has finished on station-1
+// while group-b is still playing on station-2
+func stationTestService() (*StationService, *MockStationRepository, *MockOutboxRepository) {
+	tournament := &domain.Tournament{
+		Id:     "tournament-1",
+		Status: domain.StatusActive,
+		Rounds: []domain.Round{{
+			Id:                   "round-1",
+			Type:                 domain.RoundTypeGroup,
+			ConcurrentGroupCount: 2,
+			Groups: []domain.Group{
+				{Id: "group-a", Matches: []domain.Match{{Placements: []domain.Placement{{PlayerId: "a", Placement: 1}}}}},
+				{Id: "group-b", Matches: []domain.Match{{}}},
+			},
+		}},
+	}
+	stationRepository := &MockStationRepository{stations: []domain.Station{
+		{Id: "station-1", TournamentId: tournament.Id, Number: 1, GroupId: "group-a"},
+		{Id: "station-2", TournamentId: tournament.Id, Number: 2, GroupId: "group-b"},
+	}}
+	outboxRepository := &MockOutboxRepository{}
+	stationService := NewStationService(&MockTournamentRepository{tournament: tournament}, stationRepository, outboxRepository, &MockTransactionManager{})
+	return stationService.(*StationService), stationRepository, outboxRepository
+}
+
+func TestDeleteStation(t *testing.T) {
+	t.Run("rejects a station a group is still playing on", func(t *testing.T) {
+		stationService, _, _ := stationTestService()
+		defer func() {
+			if err, ok := recover().(error); !ok || !domain.IsConflict(err) {
+				t.Errorf("Expected a conflict, got %v", err)
+			}
+		}()
+		stationService.DeleteStation(context.Background(), "tournament-1", "station-2")
+	})
+
+	t.Run("releases the station of a finished group before deleting it", func(t *testing.T) {
+		stationService, stationRepository, outboxRepository := stationTestService()
+		stationService.DeleteStation(context.Background(), "tournament-1", "station-1")
+
+		if len(stationRepository.stations) != 1 || stationRepository.stations[0].Id != "station-2" {
+			t.Errorf("Expected only station-2 to remain, got %+v", stationRepository.stations)
+		}
+		if len(outboxRepository.events) != 1 || outboxRepository.events[0].Name != "station.station-1.released" {
+			t.Errorf("Expected a released event for station-1, got %+v", outboxRepository.events)
+		}
+	})
+}
@@ -2,35 +2,47 @@ package service
 
 import (
 	"context"
-	"engine/internal/adapters/driven/event"
 	"engine/internal/adapters/driving/requests"
 	"engine/internal/domain"
 	"engine/internal/ports/input"
 	"engine/internal/ports/output"
 	"log"
+	"time"
 )
 
 // TournamentService implements the TournamentService interface
 type TournamentService struct {
 	tournamentRepository output.TournamentRepositoryInterface
-	eventBroker          *event.Broker
+	qualifyingRepository output.QualifyingRepositoryInterface
+	stationRepository    output.StationRepositoryInterface
+	outboxRepository     output.OutboxRepositoryInterface
+	transactionManager   output.TransactionManagerInterface
 }
 
 // NewTournamentService creates a new tournament service
-func NewTournamentService(tournamentRepository output.TournamentRepositoryInterface, eventBroker *event.Broker) input.TournamentServiceInterface {
+func NewTournamentService(
+	tournamentRepository output.TournamentRepositoryInterface,
+	qualifyingRepository output.QualifyingRepositoryInterface,
+	stationRepository output.StationRepositoryInterface,
+	outboxRepository output.OutboxRepositoryInterface,
+	transactionManager output.TransactionManagerInterface,
+) input.TournamentServiceInterface {
 	return &TournamentService{
 		tournamentRepository: tournamentRepository,
-		eventBroker:          eventBroker,
+		qualifyingRepository: qualifyingRepository,
+		stationRepository:    stationRepository,
+		outboxRepository:     outboxRepository,
+		transactionManager:   transactionManager,
 	}
 }
 
 // CreateTournament creates a new tournament
 func (s *TournamentService) CreateTournament(ctx context.Context, req *requests.CreateTournamentRequest) *domain.Tournament {
 	newTournament := s.buildTournamentFromRequest(req)
+	newTournament.RecordEvent("created", &newTournament)
 	savedTournament, err := s.tournamentRepository.InsertNewTournament(ctx, &newTournament)
 	s.handleRepositoryError(err)
-	log.Println("Tournament created successfully. Sending event...")
-	s.eventBroker.Publish("tournament.created", savedTournament)
+	log.Println("Tournament created successfully.")
 	return savedTournament
 }
 
@@ -49,12 +61,55 @@ func (s *TournamentService) ListTournaments(ctx context.Context) []*domain.Index
 }
 
 // UpdateTournamentStatus updates the status of a tournament
-func (s *TournamentService) UpdateTournamentStatus(ctx context.Context, id string, status domain.TournamentStatus) *domain.Tournament {
-	tournament, err := s.tournamentRepository.FindByID(ctx, id)
+func (s *TournamentService) UpdateTournamentStatus(ctx context.Context, id string, status domain.TournamentStatus) (tournament *domain.Tournament) {
+	err := s.transactionManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		tournament = s.updateTournamentStatus(ctx, id, status)
+		return nil
+	})
 	s.handleRepositoryError(err)
+	return tournament
+}
 
-	tournament.Status = status
-	tournament, err = s.tournamentRepository.Update(ctx, tournament)
+// PauseTournament interrupts an active tournament and records who paused it and why
+func (s *TournamentService) PauseTournament(ctx context.Context, id string, pausedBy string, reason string) (tournament *domain.Tournament) {
+	err := s.transactionManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		tournament, err = s.tournamentRepository.FindByIDForUpdate(ctx, id)
+		s.handleRepositoryError(err)
+
+		err = tournament.Pause(pausedBy, reason, time.Now())
+		if err != nil {
+			panic(err)
+		}
+		tournament.RecordEvent("paused", tournament)
+
+		tournament, err = s.tournamentRepository.Update(ctx, tournament)
+		return err
+	})
+	s.handleRepositoryError(err)
+	return tournament
+}
+
+// ResumeTournament continues a paused tournament
+func (s *TournamentService) ResumeTournament(ctx context.Context, id string) (tournament *domain.Tournament) {
+	err := s.transactionManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		tournament, err = s.tournamentRepository.FindByIDForUpdate(ctx, id)
+		s.handleRepositoryError(err)
+
+		if tournament.Status != domain.StatusPaused {
+			panic(domain.NewConflictError("Tournament is not paused."))
+		}
+
+		err = tournament.TransitionTo(domain.StatusActive)
+		if err != nil {
+			panic(err)
+		}
+		tournament.RecordEvent("resumed", tournament)
+
+		tournament, err = s.tournamentRepository.Update(ctx, tournament)
+		return err
+	})
 	s.handleRepositoryError(err)
 	return tournament
 }
@@ -64,18 +119,142 @@ func (s *TournamentService) DeleteTournament(ctx context.Context, id string) {
 	s.handleRepositoryError(err)
 }
 
+// updateTournamentStatus changes the status of a tournament within the running transaction
+func (s *TournamentService) updateTournamentStatus(ctx context.Context, id string, status domain.TournamentStatus) *domain.Tournament {
+	tournament, err := s.tournamentRepository.FindByIDForUpdate(ctx, id)
+	s.handleRepositoryError(err)
+
+	previousStatus := tournament.Status
+	err = tournament.TransitionTo(status)
+	if err != nil {
+		panic(err)
+	}
+
+	if previousStatus == domain.StatusDraft && status == domain.StatusActive {
+		return s.activateTournament(ctx, tournament)
+	}
+
+	tournament, err = s.tournamentRepository.Update(ctx, tournament)
+	s.handleRepositoryError(err)
+	return tournament
+}
+
+// activateTournament draws the groups of the first round and persists the activated tournament
+func (s *TournamentService) activateTournament(ctx context.Context, tournament *domain.Tournament) *domain.Tournament {
+	qualifying, err := s.qualifyingRepository.FindByTournamentId(ctx, tournament.Id)
+	s.handleRepositoryError(err)
+	players := rankPlayersByQualifying(qualifying, tournament.MainEventPlayers())
+
+	round := &tournament.Rounds[0]
+	groups, err := domain.DrawGroups(round, players, tournament.SeedingStrategy, tournament.SeedingSeed, tournament.AllowUnderfilledGroups)
+	if err != nil {
+		panic(err)
+	}
+	tournament.MapPoolFor(round).AssignGroupMaps(groups)
+	round.Groups = groups
+
+	tournament, err = s.tournamentRepository.StartRound(ctx, tournament, round)
+	s.handleRepositoryError(err)
+
+	err = allocateStations(ctx, s.stationRepository, tournament)
+	s.handleRepositoryError(err)
+
+	err = s.outboxRepository.InsertEvents(ctx, tournament)
+	s.handleRepositoryError(err)
+	return tournament
+}
+
 // buildTournamentFromRequest constructs a domain Tournament from a request
 func (s *TournamentService) buildTournamentFromRequest(req *requests.CreateTournamentRequest) domain.Tournament {
 	rounds := s.buildRoundsFromRequests(req.Rounds)
 
+	seedingStrategy := domain.SeedingStrategy(req.SeedingStrategy)
+	if seedingStrategy == "" {
+		seedingStrategy = domain.SeedingSnake
+	}
+
+	seedingSeed := time.Now().UnixNano()
+	if req.SeedingSeed != nil {
+		seedingSeed = *req.SeedingSeed
+	}
+
 	return domain.Tournament{
 		Name:                   req.Name,
 		Description:            req.Description,
 		StartDate:              req.StartDate,
 		EndDate:                req.EndDate,
 		Status:                 domain.StatusDraft,
+		PlayerCount:            req.PlayerCount,
 		Rounds:                 rounds,
 		AllowUnderfilledGroups: req.AllowUnderfilledGroups,
+		SeedingStrategy:        seedingStrategy,
+		SeedingSeed:            seedingSeed,
+		PointsScheme:           s.buildPointsScheme(req.PointsScheme, domain.DefaultPointsScheme),
+		MapPool:                s.buildMapPool(req.MapPool),
+		Qualifying:             s.buildQualifyingConfig(req.Qualifying),
+		Schedule:               s.buildScheduleConfig(req.Schedule),
+	}
+}
+
+// buildQualifyingConfig converts a qualifying request to a domain qualifying configuration
+func (s *TournamentService) buildQualifyingConfig(req *requests.QualifyingConfigRequest) domain.QualifyingConfig {
+	config := domain.QualifyingConfig{Mode: domain.QualifyingBest}
+	if req == nil {
+		return config
+	}
+
+	config.AttemptLimit = req.AttemptLimit
+	config.OpensAt = req.OpensAt
+	config.ClosesAt = req.ClosesAt
+	if req.Mode != "" {
+		config.Mode = domain.QualifyingMode(req.Mode)
+	}
+	return config
+}
+
+// buildScheduleConfig converts a schedule request to a domain schedule configuration
+func (s *TournamentService) buildScheduleConfig(req *requests.ScheduleConfigRequest) domain.ScheduleConfig {
+	if req == nil {
+		return domain.ScheduleConfig{}
+	}
+	return domain.ScheduleConfig{
+		StartsAt:             &req.StartsAt,
+		MatchDurationMinutes: req.MatchDurationMinutes,
+		StationCount:         req.StationCount,
+	}
+}
+
+// buildPointsScheme converts a points scheme request to a domain points scheme
+func (s *TournamentService) buildPointsScheme(req *requests.PointsSchemeRequest, fallback domain.PointsScheme) domain.PointsScheme {
+	if req == nil {
+		return fallback
+	}
+	return domain.PointsScheme{
+		Type:  domain.PointsSchemeType(req.Type),
+		Table: req.Table,
+	}
+}
+
+// buildMapPool converts a map pool request to a domain map pool
+func (s *TournamentService) buildMapPool(req *requests.MapPoolRequest) *domain.MapPool {
+	if req == nil {
+		return nil
+	}
+
+	strategy := domain.MapAssignmentStrategy(req.Strategy)
+	if strategy == "" {
+		strategy = domain.MapRotation
+	}
+
+	seed := time.Now().UnixNano()
+	if req.Seed != nil {
+		seed = *req.Seed
+	}
+
+	return &domain.MapPool{
+		Maps:     req.Maps,
+		Strategy: strategy,
+		Seed:     seed,
 	}
 }
 
@@ -84,15 +263,52 @@ func (s *TournamentService) buildRoundsFromRequests(requestRounds []requests.Cre
 	rounds := make([]domain.Round, 0, len(requestRounds))
 
 	for _, round := range requestRounds {
+		var pointsScheme *domain.PointsScheme
+		if round.PointsScheme != nil {
+			scheme := s.buildPointsScheme(round.PointsScheme, domain.DefaultPointsScheme)
+			pointsScheme = &scheme
+		}
+
+		tieBreakers := make([]domain.TieBreaker, 0, len(round.TieBreakers))
+		for _, tieBreaker := range round.TieBreakers {
+			tieBreakers = append(tieBreakers, domain.TieBreaker(tieBreaker))
+		}
+
+		tieBreakSeed := time.Now().UnixNano()
+		if round.TieBreakSeed != nil {
+			tieBreakSeed = *round.TieBreakSeed
+		}
+
+		roundType := domain.RoundType(round.Type)
+		if roundType == "" {
+			roundType = domain.RoundTypeGroup
+		}
+
+		roundRobinCycles := round.RoundRobinCycles
+		if roundRobinCycles == 0 {
+			roundRobinCycles = 1
+		}
+
 		newRound := domain.Round{
 			Name:                   round.Name,
+			Type:                   roundType,
 			MatchCount:             round.MatchCount,
 			PlayerAdvancementCount: round.PlayerAdvancementCount,
+			LuckyLoserCount:        round.LuckyLoserCount,
 			PlayerCount:            round.GroupCount * round.GroupSize,
 			GroupSize:              round.GroupSize,
 			ConcurrentGroupCount:   round.ConcurrentGroupCount,
+			PointsScheme:           pointsScheme,
+			MapPool:                s.buildMapPool(round.MapPool),
+			TieBreakers:            tieBreakers,
+			TieBreakSeed:           tieBreakSeed,
+			GrandFinalReset:        round.GrandFinalReset,
+			RoundRobinCycles:       roundRobinCycles,
 			Groups:                 make([]domain.Group, 0),
 		}
+		if roundType == domain.RoundTypeRoundRobin {
+			newRound.MatchCount = newRound.RoundRobinMatchCount()
+		}
 		rounds = append(rounds, newRound)
 	}
 
@@ -10,6 +10,7 @@ type Config struct {
 	Server   ServerConfig
 	GRPC     GRPCConfig
 	Database *DatabaseConfig
+	Broker   BrokerConfig
 }
 
 // ServerConfig holds the configuration for the HTTP server
@@ -26,12 +27,26 @@ type GRPCConfig struct {
 	AuthorizationServiceAddr string
 }
 
+// Event broker backends
+const (
+	// BrokerMemory distributes events within a single process
+	BrokerMemory = "memory"
+	// BrokerPostgres distributes events across all replicas using Postgres LISTEN/NOTIFY
+	BrokerPostgres = "postgres"
+)
+
+// BrokerConfig holds the configuration of the event broker
+type BrokerConfig struct {
+	Backend string
+}
+
 // Load loads the configuration from environment variables
 func Load() *Config {
 	return &Config{
 		Server:   loadServerConfig(),
 		GRPC:     loadGRPCConfig(),
 		Database: NewDatabaseConfig(), // Reuse existing function from database.go
+		Broker:   loadBrokerConfig(),
 	}
 }
 
@@ -56,3 +71,10 @@ func loadGRPCConfig() GRPCConfig {
 		AuthorizationServiceAddr: getEnv("AUTHORIZATION_SERVICE_ADDR", "localhost:50052"),
 	}
 }
+
+// loadBrokerConfig loads the event broker configuration from environment variables
+func loadBrokerConfig() BrokerConfig {
+	return BrokerConfig{
+		Backend: getEnv("EVENT_BROKER", BrokerMemory),
+	}
+}
@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"cmp"
+	"sort"
+)
+
+// IsComplete reports whether every match of every group of the round has placements.
+// A Swiss round is only complete once all of its stages have been played,
+// an elimination round once its final match has been decided.
+func (r *Round) IsComplete() bool {
+	if len(r.Groups) == 0 {
+		return false
+	}
+
+	if r.Type.IsElimination() {
+		final := r.Groups[0].Matches
+		return len(final) > 0 && len(final[len(final)-1].Placements) > 0
+	}
+
+	for i := range r.Groups {
+		group := &r.Groups[i]
+		if !group.isComplete() {
+			return false
+		}
+		if r.Type == RoundTypeSwiss && group.StageCount() < r.MatchCount {
+			return false
+		}
+	}
+
+	return true
+}
+
+// NextRound returns the round following the given round, or nil if it is the last round
+func (t *Tournament) NextRound(round *Round) *Round {
+	for i := range t.Rounds {
+		if t.Rounds[i].Id == round.Id && i+1 < len(t.Rounds) {
+			return &t.Rounds[i+1]
+		}
+	}
+	return nil
+}
+
+// CheckRoundOpen ensures that the results of a round may still change. Once a completed round has advanced
+// its players into the next round, a changed result would no longer match the drawn groups.
+func (t *Tournament) CheckRoundOpen(round *Round) error {
+	if !round.IsComplete() {
+		return nil
+	}
+	if nextRound := t.NextRound(round); nextRound != nil && len(nextRound.Groups) > 0 {
+		return NewConflictError("results cannot be changed after the next round was drawn")
+	}
+	return nil
+}
+
+// AdvancingPlayers returns the top PlayerAdvancementCount players of every group, ordered by rank:
+// all group winners first (in group order), then all runners-up, and so on.
+// Swiss rounds play in a single pool, so they advance PlayerAdvancementCount players per table.
+// Elimination rounds are decided by their bracket instead, see BracketAdvancingPlayers.
+// The LuckyLoserCount best non-qualified players across all groups follow after the regular qualifiers.
+func AdvancingPlayers(round *Round, standings []*GroupStandings, tieBreakers TieBreakerChain, tournamentId string) []Player {
+	advancementCount := round.PoolAdvancementCount()
+
+	players := make([]Player, 0, advancementCount*len(standings)+round.LuckyLoserCount)
+
+	for rank := 0; rank < advancementCount; rank++ {
+		for _, groupStandings := range standings {
+			if rank >= len(groupStandings.Standings) {
+				continue
+			}
+			players = append(players, advancingPlayer(groupStandings.Standings[rank], tournamentId))
+		}
+	}
+
+	for _, standing := range LuckyLosers(standings, advancementCount, round.LuckyLoserCount, tieBreakers) {
+		players = append(players, advancingPlayer(standing, tournamentId))
+	}
+
+	return players
+}
+
+// LuckyLosers returns the best count players that did not finish within the top advancementCount of their group.
+// Players of different groups never met, so they are compared by points per match played and then by every
+// tie-breaker of the chain except head-to-head. Underfilled groups play fewer matches, so raw points would favour
+// the players of larger groups.
+func LuckyLosers(standings []*GroupStandings, advancementCount int, count int, tieBreakers TieBreakerChain) []Standing {
+	if count <= 0 {
+		return []Standing{}
+	}
+
+	candidates := make([]Standing, 0)
+	for _, groupStandings := range standings {
+		if advancementCount < len(groupStandings.Standings) {
+			candidates = append(candidates, groupStandings.Standings[advancementCount:]...)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := &candidates[i], &candidates[j]
+		if result := comparePointsPerMatch(a, b); result != 0 {
+			return result < 0
+		}
+		for _, rule := range tieBreakers.Rules {
+			if result := tieBreakers.compare(rule, a, b, nil); result != 0 {
+				return result < 0
+			}
+		}
+		return a.PlayerId < b.PlayerId
+	})
+
+	if count < len(candidates) {
+		candidates = candidates[:count]
+	}
+	return candidates
+}
+
+// BracketAdvancingPlayers returns the players advancing from a decided elimination round ordered by their bracket
+// result. The round advances PlayerAdvancementCount players per table and its lucky losers, the next best players.
+func BracketAdvancingPlayers(round *Round, tournamentId string) []Player {
+	if len(round.Groups) == 0 {
+		return []Player{}
+	}
+
+	ranking := round.Groups[0].BracketRanking()
+	count := min(round.PoolAdvancementCount()+round.LuckyLoserCount, len(ranking))
+
+	players := make([]Player, 0, count)
+	for _, player := range ranking[:count] {
+		players = append(players, Player{
+			Id:           player.Id,
+			Name:         player.Name,
+			TournamentId: tournamentId,
+		})
+	}
+	return players
+}
+
+// comparePointsPerMatch returns a negative number if a scored more points per match played than b.
+// The averages are compared by cross-multiplying, so they are exact. A player without matches has no points.
+func comparePointsPerMatch(a *Standing, b *Standing) int {
+	return cmp.Compare(b.Points*max(a.MatchesPlayed, 1), a.Points*max(b.MatchesPlayed, 1))
+}
+
+func advancingPlayer(standing Standing, tournamentId string) Player {
+	return Player{
+		Id:           standing.PlayerId,
+		Name:         standing.PlayerName,
+		TournamentId: tournamentId,
+	}
+}
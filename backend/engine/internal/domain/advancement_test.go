@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestAdvancingPlayersWithLuckyLosers(t *testing.T) {
+	standings := []*GroupStandings{
+		{Standings: []Standing{
+			{PlayerId: "a1", Points: 30},
+			{PlayerId: "a2", Points: 20, Wins: 1},
+			{PlayerId: "a3", Points: 5},
+		}},
+		{Standings: []Standing{
+			{PlayerId: "b1", Points: 28},
+			{PlayerId: "b2", Points: 20},
+			{PlayerId: "b3", Points: 12},
+		}},
+	}
+	round := &Round{Type: RoundTypeGroup, PlayerAdvancementCount: 1, LuckyLoserCount: 2}
+	chain := TieBreakerChain{Rules: []TieBreaker{TieBreakMostWins, TieBreakCoinFlip}}
+
+	players := AdvancingPlayers(round, standings, chain, "tournament-1")
+
+	expected := []string{"a1", "b1", "a2", "b2"}
+	if len(players) != len(expected) {
+		t.Fatalf("Expected %d advancing players, got %d", len(expected), len(players))
+	}
+	for i, id := range expected {
+		if players[i].Id != id {
+			t.Errorf("Expected %s at position %d, got %s", id, i+1, players[i].Id)
+		}
+	}
+}
+
+func TestLuckyLosersComparePointsPerMatch(t *testing.T) {
+	// Group a is full and played three matches, the underfilled group b only two
+	standings := []*GroupStandings{
+		{Standings: []Standing{
+			{PlayerId: "a1", Points: 30, MatchesPlayed: 3},
+			{PlayerId: "a2", Points: 12, MatchesPlayed: 3},
+		}},
+		{Standings: []Standing{
+			{PlayerId: "b1", Points: 20, MatchesPlayed: 2},
+			{PlayerId: "b2", Points: 10, MatchesPlayed: 2},
+		}},
+	}
+	chain := TieBreakerChain{Rules: []TieBreaker{TieBreakCoinFlip}}
+
+	luckyLosers := LuckyLosers(standings, 1, 1, chain)
+
+	if len(luckyLosers) != 1 || luckyLosers[0].PlayerId != "b2" {
+		t.Errorf("Expected b2 with 5 points per match ahead of a2 with 4, got %+v", luckyLosers)
+	}
+}
+
+func TestCheckRoundOpen(t *testing.T) {
+	tournament := &Tournament{Rounds: []Round{
+		{Id: "round-1", Type: RoundTypeGroup, Groups: []Group{{
+			Players: []Player{{Id: "a"}, {Id: "b"}},
+			Matches: []Match{{Placements: []Placement{{PlayerId: "a", Placement: 1}, {PlayerId: "b", Placement: 2}}}},
+		}}},
+		{Id: "round-2", Type: RoundTypeGroup},
+	}}
+	round := &tournament.Rounds[0]
+
+	if err := tournament.CheckRoundOpen(round); err != nil {
+		t.Errorf("Expected results to be changeable before the next round is drawn, got %v", err)
+	}
+
+	tournament.Rounds[1].Groups = []Group{{Players: []Player{{Id: "a"}}}}
+	if err := tournament.CheckRoundOpen(round); !IsConflict(err) {
+		t.Errorf("Expected changing results after the next round was drawn to be rejected with a conflict, got %v", err)
+	}
+}
@@ -0,0 +1,379 @@
+package domain
+
+import (
+	"math"
+	"sort"
+)
+
+// Bracket identifies the part of an elimination round a match belongs to
+type Bracket string
+
+const (
+	// BracketWinners holds the matches of players without a loss
+	BracketWinners Bracket = "WINNERS"
+	// BracketLosers holds the matches of players with one loss in a double elimination round
+	BracketLosers Bracket = "LOSERS"
+	// BracketGrandFinal holds the final between the winners of both brackets and its optional reset
+	BracketGrandFinal Bracket = "GRAND_FINAL"
+)
+
+// BracketName is the name of the single group an elimination round is played in
+const BracketName = "Bracket"
+
+// drawBracket places all players, ordered by rank, into a single group and generates every match of the bracket.
+// The bracket is padded to the next power of two; the best seeds receive the resulting byes.
+func drawBracket(round *Round, players []Player) []Group {
+	bracket := Group{
+		Name:     BracketName,
+		RoundId:  round.Id,
+		Position: 0,
+		Players:  append(make([]Player, 0, len(players)), players...),
+	}
+
+	size := 2
+	for size < len(players) {
+		size *= 2
+	}
+
+	builder := &bracketBuilder{}
+	winners := builder.addRounds(BracketWinners, winnersBracketSizes(size))
+
+	for i, seed := range bracketSeedOrder(size) {
+		if seed <= len(players) {
+			match := &builder.matches[winners[0][i/2]]
+			match.Players = append(match.Players, players[seed-1])
+		}
+	}
+	for r := 0; r+1 < len(winners); r++ {
+		for i, position := range winners[r] {
+			builder.link(position, winners[r+1][i/2], false)
+		}
+	}
+
+	if round.Type == RoundTypeDoubleElimination {
+		losers := builder.addRounds(BracketLosers, losersBracketSizes(size))
+		grandFinal := builder.addRounds(BracketGrandFinal, []int{1})[0][0]
+
+		builder.link(winners[len(winners)-1][0], grandFinal, false)
+		if len(losers) == 0 {
+			builder.link(winners[len(winners)-1][0], grandFinal, true)
+		} else {
+			for i, position := range winners[0] {
+				builder.link(position, losers[0][i/2], true)
+			}
+			for r := 1; r < len(winners); r++ {
+				for i, position := range winners[r] {
+					builder.link(position, losers[2*r-1][i], true)
+				}
+			}
+			for k := 0; k+1 < len(losers); k++ {
+				for i, position := range losers[k] {
+					if k%2 == 0 {
+						builder.link(position, losers[k+1][i], false)
+					} else {
+						builder.link(position, losers[k+1][i/2], false)
+					}
+				}
+			}
+			builder.link(losers[len(losers)-1][0], grandFinal, false)
+		}
+	}
+
+	bracket.Matches = builder.matches
+	bracket.AdvanceBracket(round)
+	return []Group{bracket}
+}
+
+// AdvanceBracket moves the winners and losers of all decided matches into their successor matches
+// and resolves byes. If the grand final reset is enabled and the player coming from the losers bracket
+// wins the grand final, a reset match is added. It returns the matches that were changed or added.
+func (g *Group) AdvanceBracket(round *Round) (changed []*Match, added []Match) {
+	rank := make(map[string]int, len(g.Players))
+	for i, player := range g.Players {
+		rank[player.Id] = i
+	}
+
+	feeders := make(map[int]int)
+	for _, match := range g.Matches {
+		if match.NextMatchPosition != nil {
+			feeders[*match.NextMatchPosition]++
+		}
+		if match.LoserNextMatchPosition != nil {
+			feeders[*match.LoserNextMatchPosition]++
+		}
+	}
+
+	isChanged := make(map[int]bool)
+	resolvedFeeders := make(map[int]int)
+	resolve := func(match *Match) {
+		if match.NextMatchPosition != nil {
+			resolvedFeeders[*match.NextMatchPosition]++
+		}
+		if match.LoserNextMatchPosition != nil {
+			resolvedFeeders[*match.LoserNextMatchPosition]++
+		}
+	}
+
+	sort.SliceStable(g.Matches, func(i, j int) bool {
+		return g.Matches[i].Position < g.Matches[j].Position
+	})
+
+	for i := range g.Matches {
+		match := &g.Matches[i]
+
+		if len(match.Placements) == 0 {
+			if resolvedFeeders[match.Position] < feeders[match.Position] || len(match.Players) > 1 {
+				continue
+			}
+			if len(match.Players) == 1 {
+				match.Placements = []Placement{{PlayerId: match.Players[0].Id, Placement: 1}}
+				isChanged[match.Position] = true
+			}
+		}
+
+		resolve(match)
+		winner, loser := bracketResult(match)
+		if winner != nil && match.NextMatchPosition != nil {
+			if g.seatPlayer(*match.NextMatchPosition, *winner, rank) {
+				isChanged[*match.NextMatchPosition] = true
+			}
+		}
+		if loser != nil && match.LoserNextMatchPosition != nil {
+			if g.seatPlayer(*match.LoserNextMatchPosition, *loser, rank) {
+				isChanged[*match.LoserNextMatchPosition] = true
+			}
+		}
+	}
+
+	if reset := g.grandFinalReset(round); reset != nil {
+		added = append(added, *reset)
+	}
+
+	for i := range g.Matches {
+		if isChanged[g.Matches[i].Position] {
+			changed = append(changed, &g.Matches[i])
+		}
+	}
+
+	return changed, added
+}
+
+// BracketRanking returns the players of the bracket ordered by their result: the champion first, followed by
+// every other player by how late they were eliminated. Players eliminated in the same bracket round are
+// ordered by seed. Byes are not results, so they do not affect the ranking.
+func (g *Group) BracketRanking() []Player {
+	matches := make([]*Match, 0, len(g.Matches))
+	for i := range g.Matches {
+		matches = append(matches, &g.Matches[i])
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Position < matches[j].Position
+	})
+
+	// Every bracket round is identified by the position of its first match, which grows with the depth of the round
+	type bracketRound struct {
+		bracket Bracket
+		stage   int
+	}
+	roundDepth := make(map[bracketRound]int)
+	lastMatch := make(map[string]*Match)
+	lastLoss := make(map[string]*Match)
+	for _, match := range matches {
+		key := bracketRound{match.Bracket, match.Stage}
+		if _, ok := roundDepth[key]; !ok {
+			roundDepth[key] = match.Position
+		}
+		for _, player := range match.Players {
+			lastMatch[player.Id] = match
+		}
+		if _, loser := bracketResult(match); loser != nil {
+			lastLoss[loser.Id] = match
+		}
+	}
+
+	// A player is eliminated by a loss after which they did not play again
+	depth := func(player Player) int {
+		loss, ok := lastLoss[player.Id]
+		if !ok || lastMatch[player.Id] != loss {
+			return math.MaxInt
+		}
+		return roundDepth[bracketRound{loss.Bracket, loss.Stage}]
+	}
+
+	ranking := append(make([]Player, 0, len(g.Players)), g.Players...)
+	sort.SliceStable(ranking, func(i, j int) bool {
+		return depth(ranking[i]) > depth(ranking[j])
+	})
+	return ranking
+}
+
+// CheckBracketMatchReady ensures that both players of a bracket match are known and the result is not recorded yet
+func (r *Round) CheckBracketMatchReady(match *Match) error {
+	if !r.Type.IsElimination() {
+		return nil
+	}
+	if len(match.Players) != 2 {
+		return NewNotAllowedError("the players of this match are not known yet")
+	}
+	if len(match.Placements) > 0 {
+		return NewNotAllowedError("the result of a bracket match cannot be changed once it was recorded")
+	}
+	return nil
+}
+
+// grandFinalReset returns the reset match if the player coming from the losers bracket won the grand final
+func (g *Group) grandFinalReset(round *Round) *Match {
+	if round.Type != RoundTypeDoubleElimination || !round.GrandFinalReset {
+		return nil
+	}
+
+	var grandFinal *Match
+	for i := range g.Matches {
+		if g.Matches[i].Bracket == BracketGrandFinal {
+			if g.Matches[i].Stage > 0 {
+				return nil
+			}
+			grandFinal = &g.Matches[i]
+		}
+	}
+
+	if grandFinal == nil || len(grandFinal.Players) != 2 {
+		return nil
+	}
+
+	winner, _ := bracketResult(grandFinal)
+	if winner == nil || !g.hasLost(winner.Id, grandFinal.Position) {
+		return nil
+	}
+
+	return &Match{
+		Position:   g.Matches[len(g.Matches)-1].Position + 1,
+		Stage:      1,
+		Bracket:    BracketGrandFinal,
+		Players:    append(make([]Player, 0, 2), grandFinal.Players...),
+		Placements: make([]Placement, 0),
+	}
+}
+
+// hasLost reports whether the player lost a match before the given position
+func (g *Group) hasLost(playerId string, before int) bool {
+	for i := range g.Matches {
+		if g.Matches[i].Position >= before {
+			continue
+		}
+		if _, loser := bracketResult(&g.Matches[i]); loser != nil && loser.Id == playerId {
+			return true
+		}
+	}
+	return false
+}
+
+// seatPlayer adds the player to the match at the given position, keeping the players ordered by seed
+func (g *Group) seatPlayer(position int, player Player, rank map[string]int) bool {
+	for i := range g.Matches {
+		match := &g.Matches[i]
+		if match.Position != position {
+			continue
+		}
+		for _, seated := range match.Players {
+			if seated.Id == player.Id {
+				return false
+			}
+		}
+		// A conflicting result of a feeder match must never seat a third player
+		if len(match.Players) >= 2 {
+			return false
+		}
+		match.Players = append(match.Players, player)
+		sort.SliceStable(match.Players, func(a, b int) bool {
+			return rank[match.Players[a].Id] < rank[match.Players[b].Id]
+		})
+		return true
+	}
+	return false
+}
+
+// bracketResult returns the winner and loser of a decided match. A bye has no loser.
+func bracketResult(match *Match) (winner *Player, loser *Player) {
+	for _, placement := range match.Placements {
+		for i := range match.Players {
+			if match.Players[i].Id != placement.PlayerId {
+				continue
+			}
+			switch placement.Placement {
+			case 1:
+				winner = &match.Players[i]
+			case 2:
+				loser = &match.Players[i]
+			}
+		}
+	}
+	return winner, loser
+}
+
+// bracketSeedOrder returns the seeds of a bracket in slot order, so that the best seeds meet as late as possible
+func bracketSeedOrder(size int) []int {
+	order := []int{1}
+	for len(order) < size {
+		next := make([]int, 0, len(order)*2)
+		for _, seed := range order {
+			next = append(next, seed, len(order)*2+1-seed)
+		}
+		order = next
+	}
+	return order
+}
+
+// winnersBracketSizes returns the number of matches of every round of the winners bracket
+func winnersBracketSizes(size int) []int {
+	sizes := make([]int, 0)
+	for matches := size / 2; matches >= 1; matches /= 2 {
+		sizes = append(sizes, matches)
+	}
+	return sizes
+}
+
+// losersBracketSizes returns the number of matches of every round of the losers bracket.
+// Rounds alternate between losers playing each other and losers facing players dropping down from the winners bracket.
+func losersBracketSizes(size int) []int {
+	sizes := make([]int, 0)
+	for matches := size / 4; matches >= 1; matches /= 2 {
+		sizes = append(sizes, matches, matches)
+	}
+	return sizes
+}
+
+// bracketBuilder numbers the matches of a bracket and links them to their successors
+type bracketBuilder struct {
+	matches []Match
+}
+
+// addRounds adds the matches of a part of the bracket and returns their positions per round
+func (b *bracketBuilder) addRounds(bracket Bracket, sizes []int) [][]int {
+	rounds := make([][]int, 0, len(sizes))
+	for stage, size := range sizes {
+		positions := make([]int, 0, size)
+		for i := 0; i < size; i++ {
+			position := len(b.matches)
+			b.matches = append(b.matches, Match{
+				Position:   position,
+				Stage:      stage,
+				Bracket:    bracket,
+				Players:    make([]Player, 0, 2),
+				Placements: make([]Placement, 0),
+			})
+			positions = append(positions, position)
+		}
+		rounds = append(rounds, positions)
+	}
+	return rounds
+}
+
+func (b *bracketBuilder) link(from int, to int, loser bool) {
+	target := to
+	if loser {
+		b.matches[from].LoserNextMatchPosition = &target
+	} else {
+		b.matches[from].NextMatchPosition = &target
+	}
+}
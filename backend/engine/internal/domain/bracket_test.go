@@ -0,0 +1,161 @@
+package domain
+
+import (
+	"testing"
+)
+
+func bracketTestDraw(t *testing.T, round *Round, playerIds ...string) *Group {
+	players := make([]Player, 0, len(playerIds))
+	for _, playerId := range playerIds {
+		players = append(players, Player{Id: playerId})
+	}
+	groups, err := DrawGroups(round, players, SeedingSnake, 0, true)
+	if err != nil {
+		t.Fatalf("Expected the bracket to be drawn, got %v", err)
+	}
+	return &groups[0]
+}
+
+// decide records the winner of the match at the given position and advances the bracket
+func decide(t *testing.T, round *Round, bracket *Group, position int, winnerId string) []Match {
+	match := &bracket.Matches[position]
+	if err := round.CheckBracketMatchReady(match); err != nil {
+		t.Fatalf("Expected match %d to be ready, got %v", position, err)
+	}
+	for _, player := range match.Players {
+		placement := 2
+		if player.Id == winnerId {
+			placement = 1
+		}
+		match.Placements = append(match.Placements, Placement{PlayerId: player.Id, Placement: placement})
+	}
+	_, added := bracket.AdvanceBracket(round)
+	bracket.Matches = append(bracket.Matches, added...)
+	return added
+}
+
+func TestDrawBracket(t *testing.T) {
+	round := &Round{Type: RoundTypeSingleElimination, PlayerCount: 8, GroupSize: 2}
+	bracket := bracketTestDraw(t, round, "1", "2", "3", "4", "5")
+
+	if len(bracket.Matches) != 7 {
+		t.Fatalf("Expected 7 matches for a bracket of 8, got %d", len(bracket.Matches))
+	}
+
+	// Seeds 1, 2 and 3 receive byes and are already seated in the second round
+	semifinals := []string{tablePlayers(bracket.Matches[4]), tablePlayers(bracket.Matches[5])}
+	if semifinals[0] != "1" || semifinals[1] != "23" {
+		t.Fatalf("Expected second round 1 and 23, got %v", semifinals)
+	}
+
+	decide(t, round, bracket, 1, "5")
+	if tablePlayers(bracket.Matches[4]) != "15" {
+		t.Errorf("Expected the winner of 4 vs 5 to face seed 1, got %s", tablePlayers(bracket.Matches[4]))
+	}
+
+	decide(t, round, bracket, 4, "1")
+	decide(t, round, bracket, 5, "3")
+	round.Groups = []Group{*bracket}
+	if round.IsComplete() {
+		t.Error("Expected the round to be incomplete before the final")
+	}
+	decide(t, round, bracket, 6, "3")
+	if !round.IsComplete() {
+		t.Error("Expected the round to be complete after the final")
+	}
+}
+
+func TestDoubleEliminationGrandFinalReset(t *testing.T) {
+	round := &Round{Type: RoundTypeDoubleElimination, PlayerCount: 4, GroupSize: 2, GrandFinalReset: true}
+	bracket := bracketTestDraw(t, round, "1", "2", "3", "4")
+
+	// Winners bracket: 0 (1v4), 1 (2v3), 2 final; losers bracket: 3, 4; grand final: 5
+	if len(bracket.Matches) != 6 {
+		t.Fatalf("Expected 6 matches, got %d", len(bracket.Matches))
+	}
+
+	decide(t, round, bracket, 0, "1")
+	decide(t, round, bracket, 1, "2")
+	if tablePlayers(bracket.Matches[3]) != "34" {
+		t.Fatalf("Expected the first round losers to meet in the losers bracket, got %s", tablePlayers(bracket.Matches[3]))
+	}
+
+	decide(t, round, bracket, 2, "1")
+	decide(t, round, bracket, 3, "3")
+	if tablePlayers(bracket.Matches[4]) != "23" {
+		t.Fatalf("Expected the winners bracket final loser to drop down, got %s", tablePlayers(bracket.Matches[4]))
+	}
+
+	decide(t, round, bracket, 4, "2")
+	added := decide(t, round, bracket, 5, "2")
+	if len(added) != 1 || tablePlayers(added[0]) != "12" {
+		t.Fatalf("Expected a grand final reset between 1 and 2, got %+v", added)
+	}
+
+	if added := decide(t, round, bracket, 6, "2"); len(added) != 0 {
+		t.Errorf("Expected no second reset, got %+v", added)
+	}
+}
+
+func TestAdvanceBracketNeverSeatsThirdPlayer(t *testing.T) {
+	round := &Round{Type: RoundTypeSingleElimination, PlayerCount: 4, GroupSize: 2}
+	bracket := bracketTestDraw(t, round, "1", "2", "3", "4")
+
+	decide(t, round, bracket, 0, "1")
+	decide(t, round, bracket, 1, "2")
+	if tablePlayers(bracket.Matches[2]) != "12" {
+		t.Fatalf("Expected the final between 1 and 2, got %s", tablePlayers(bracket.Matches[2]))
+	}
+
+	if err := round.CheckBracketMatchReady(&bracket.Matches[0]); !IsNotAllowed(err) {
+		t.Errorf("Expected a recorded result to be final, got %v", err)
+	}
+
+	// A conflicting result that slipped through must not add the loser to the final
+	bracket.Matches[0].Placements = []Placement{{PlayerId: "4", Placement: 1}, {PlayerId: "1", Placement: 2}}
+	bracket.AdvanceBracket(round)
+	if tablePlayers(bracket.Matches[2]) != "12" {
+		t.Errorf("Expected the final to keep its two players, got %s", tablePlayers(bracket.Matches[2]))
+	}
+}
+
+func TestBracketRanking(t *testing.T) {
+	rankingIds := func(bracket *Group) string {
+		ids := ""
+		for _, player := range bracket.BracketRanking() {
+			ids += player.Id
+		}
+		return ids
+	}
+
+	t.Run("single elimination with byes", func(t *testing.T) {
+		round := &Round{Type: RoundTypeSingleElimination, PlayerCount: 8, GroupSize: 2}
+		bracket := bracketTestDraw(t, round, "1", "2", "3", "4", "5")
+		decide(t, round, bracket, 1, "5")
+		decide(t, round, bracket, 4, "1")
+		decide(t, round, bracket, 5, "3")
+		decide(t, round, bracket, 6, "3")
+
+		// Both semifinal losers are ordered by seed
+		if ranking := rankingIds(bracket); ranking != "31254" {
+			t.Errorf("Expected ranking 31254, got %s", ranking)
+		}
+	})
+
+	t.Run("double elimination with grand final reset", func(t *testing.T) {
+		round := &Round{Type: RoundTypeDoubleElimination, PlayerCount: 4, GroupSize: 2, GrandFinalReset: true}
+		bracket := bracketTestDraw(t, round, "1", "2", "3", "4")
+		decide(t, round, bracket, 0, "1")
+		decide(t, round, bracket, 1, "2")
+		decide(t, round, bracket, 2, "1")
+		decide(t, round, bracket, 3, "3")
+		decide(t, round, bracket, 4, "2")
+		decide(t, round, bracket, 5, "2")
+		decide(t, round, bracket, 6, "2")
+
+		// Player 1 lost the grand final only after the reset, player 3 in the losers bracket final
+		if ranking := rankingIds(bracket); ranking != "2134" {
+			t.Errorf("Expected ranking 2134, got %s", ranking)
+		}
+	})
+}
@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// CalendarEvent is a single entry of the calendar export of a tournament.
+// The UID only depends on the tournament and the position of the event, so re-imports update existing entries.
+type CalendarEvent struct {
+	UID         string
+	Summary     string
+	Description string
+	Start       time.Time
+	End         time.Time
+	// AllDay events only use the date of Start and End. End is exclusive.
+	AllDay bool
+}
+
+// calendarDateLayouts are the accepted formats of the start and end date of a tournament
+var calendarDateLayouts = []string{time.DateOnly, time.RFC3339}
+
+// CalendarEvents returns the tournament dates, the qualifying window and the slot of every group as calendar events.
+// If a player id is given, only the slots of groups the player has been drawn into are included.
+// The schedule may be nil if the tournament has no schedule.
+func (t *Tournament) CalendarEvents(schedule *Schedule, playerId string) ([]CalendarEvent, error) {
+	if playerId != "" && !slices.ContainsFunc(t.Players, func(player Player) bool { return player.Id == playerId }) {
+		return nil, NewNotFoundError("player not found")
+	}
+
+	events := make([]CalendarEvent, 0)
+
+	start, startErr := parseCalendarDate(t.StartDate)
+	end, endErr := parseCalendarDate(t.EndDate)
+	if startErr == nil && endErr == nil {
+		events = append(events, CalendarEvent{
+			UID:         t.calendarUID("tournament"),
+			Summary:     t.Name,
+			Description: t.Description,
+			Start:       start,
+			End:         end.AddDate(0, 0, 1),
+			AllDay:      true,
+		})
+	}
+
+	opensAt, closesAt := t.Qualifying.OpensAt, t.Qualifying.ClosesAt
+	if opensAt == nil {
+		opensAt = t.Qualifying.OpenedAt
+	}
+	if closesAt == nil {
+		closesAt = t.Qualifying.ClosedAt
+	}
+	if opensAt != nil && closesAt != nil {
+		events = append(events, CalendarEvent{
+			UID:     t.calendarUID("qualifying"),
+			Summary: t.Name + ": Qualifying",
+			Start:   *opensAt,
+			End:     *closesAt,
+		})
+	}
+
+	if schedule == nil {
+		return events, nil
+	}
+
+	groupIndex := make(map[string]int)
+	for _, slot := range schedule.Slots {
+		for _, scheduled := range slot.Groups {
+			index := groupIndex[slot.RoundId]
+			groupIndex[slot.RoundId]++
+
+			if playerId != "" && !t.isPlayerInGroup(slot.RoundId, scheduled.GroupId, playerId) {
+				continue
+			}
+
+			events = append(events, CalendarEvent{
+				UID:         t.calendarUID(fmt.Sprintf("round-%s-group-%d", slot.RoundId, index)),
+				Summary:     fmt.Sprintf("%s: %s %s", t.Name, slot.RoundName, scheduled.GroupName),
+				Description: calendarStations(scheduled.Stations),
+				Start:       slot.StartsAt,
+				End:         slot.EndsAt,
+			})
+		}
+	}
+
+	return events, nil
+}
+
+// isPlayerInGroup reports whether the player has been drawn into the group
+func (t *Tournament) isPlayerInGroup(roundId string, groupId string, playerId string) bool {
+	if groupId == "" {
+		return false
+	}
+	round, err := t.FindRound(roundId)
+	if err != nil {
+		return false
+	}
+	group, err := round.FindGroup(groupId)
+	if err != nil {
+		return false
+	}
+	return slices.ContainsFunc(group.Players, func(player Player) bool { return player.Id == playerId })
+}
+
+func (t *Tournament) calendarUID(event string) string {
+	return fmt.Sprintf("%s-%s@tournament", t.Id, event)
+}
+
+func calendarStations(stations []int) string {
+	if len(stations) == 0 {
+		return ""
+	}
+	if len(stations) == 1 {
+		return fmt.Sprintf("Station %d", stations[0])
+	}
+	description := "Stations"
+	for i, station := range stations {
+		if i > 0 {
+			description += ","
+		}
+		description += fmt.Sprintf(" %d", station)
+	}
+	return description
+}
+
+func parseCalendarDate(value string) (time.Time, error) {
+	for _, layout := range calendarDateLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, NewInvalidParameterError("invalid date " + value)
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTournamentCalendarEvents(t *testing.T) {
+	startsAt := time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+	tournament := &Tournament{
+		Id:        "tournament-1",
+		Name:      "Cup",
+		StartDate: "2026-10-17",
+		EndDate:   "2026-10-18",
+		Players:   []Player{{Id: "a"}, {Id: "b"}},
+		Schedule:  ScheduleConfig{StartsAt: &startsAt, MatchDurationMinutes: 10},
+		Rounds: []Round{{
+			Id:                   "round-1",
+			Name:                 "Groups",
+			PlayerCount:          4,
+			GroupSize:            2,
+			MatchCount:           1,
+			ConcurrentGroupCount: 1,
+			Groups: []Group{
+				{Id: "group-a", Name: "Group A", Players: []Player{{Id: "a"}}, Matches: []Match{{}}},
+				{Id: "group-b", Name: "Group B", Players: []Player{{Id: "b"}}, Matches: []Match{{}}},
+			},
+		}},
+	}
+	schedule, err := tournament.BuildSchedule(nil, nil)
+	if err != nil {
+		t.Fatalf("Expected schedule, got %v", err)
+	}
+
+	t.Run("contains the tournament and every group with stable uids", func(t *testing.T) {
+		events, err := tournament.CalendarEvents(schedule, "")
+		if err != nil {
+			t.Fatalf("Expected events, got %v", err)
+		}
+
+		if len(events) != 3 {
+			t.Fatalf("Expected 3 events, got %d", len(events))
+		}
+		if !events[0].AllDay || events[0].End.Format(time.DateOnly) != "2026-10-19" {
+			t.Errorf("Expected an all-day tournament event ending exclusively on 2026-10-19, got %+v", events[0])
+		}
+		if events[2].UID != "tournament-1-round-round-1-group-1@tournament" {
+			t.Errorf("Expected a uid derived from the round and group position, got %s", events[2].UID)
+		}
+	})
+
+	t.Run("limits the groups to those of the player", func(t *testing.T) {
+		events, err := tournament.CalendarEvents(schedule, "b")
+		if err != nil {
+			t.Fatalf("Expected events, got %v", err)
+		}
+
+		if len(events) != 2 || events[1].Summary != "Cup: Groups Group B" {
+			t.Errorf("Expected the tournament and group B, got %+v", events)
+		}
+	})
+
+	t.Run("rejects unknown players", func(t *testing.T) {
+		if _, err := tournament.CalendarEvents(schedule, "unknown"); !IsNotFound(err) {
+			t.Errorf("Expected not found error, got %v", err)
+		}
+	})
+}
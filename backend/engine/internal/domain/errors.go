@@ -35,6 +35,12 @@ type ErrNotAllowed interface {
 	NotAllowed()
 }
 
+// ErrConflict signals that the requested action conflicts with the current state of the object
+type ErrConflict interface {
+	error
+	Conflict()
+}
+
 // Error implementations
 
 type errNotFound struct{ error }
@@ -62,6 +68,11 @@ type errNotAllowed struct{ error }
 func (errNotAllowed) NotAllowed()     {}
 func (e errNotAllowed) Unwrap() error { return e.error }
 
+type errConflict struct{ error }
+
+func (errConflict) Conflict()       {}
+func (e errConflict) Unwrap() error { return e.error }
+
 // Helper functions to create errors
 
 // NewNotFoundError creates a new ErrNotFound from the given error or message
@@ -87,6 +98,11 @@ func NewForbiddenError(msg string) error {
 // NewNotAllowedError creates a new ErrNotAllowed from the given error or message
 func NewNotAllowedError(msg string) error { return errNotAllowed{errors.New(msg)} }
 
+// NewConflictError creates a new ErrConflict from the given error or message
+func NewConflictError(msg string) error {
+	return errConflict{errors.New(msg)}
+}
+
 // Helper functions to check error types
 
 // IsNotFound returns true if the error is an ErrNotFound
@@ -117,3 +133,9 @@ func IsNotAllowed(err error) bool {
 	var notAllowed ErrNotAllowed
 	return errors.As(err, &notAllowed)
 }
+
+// IsConflict returns true if the error is an ErrConflict
+func IsConflict(err error) bool {
+	var conflict ErrConflict
+	return errors.As(err, &conflict)
+}
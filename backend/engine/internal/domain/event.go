@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Event is a change of a tournament that is announced to subscribers.
+// Events are written to the outbox in the same transaction as the change that caused them.
+type Event struct {
+	// Name is the topic of the event below its tournament, e.g. created or qualifying.closed
+	Name    string
+	Payload any
+}
+
+// OutboxEvent is an event waiting in the outbox to be published
+type OutboxEvent struct {
+	// Table: outbox_events
+	Id           int64
+	TournamentId string
+	Name         string
+	Payload      json.RawMessage
+	CreatedAt    time.Time
+	DispatchedAt *time.Time
+}
+
+// RecordEvent adds an event that is published once the tournament has been saved
+func (t *Tournament) RecordEvent(name string, payload any) {
+	t.Events = append(t.Events, Event{Name: name, Payload: payload})
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"fmt"
+)
+
+// DrawGroups splits the given players, ordered by rank, into the groups of a round using the given seeding strategy.
+// Each group receives MatchCount empty matches. A Swiss round places all players into a single pool
+// and only pairs its first stage, an elimination round generates its whole bracket
+// and a round-robin round generates all pairings of every group.
+func DrawGroups(round *Round, players []Player, strategy SeedingStrategy, seed int64, allowUnderfilledGroups bool) ([]Group, error) {
+	groupCount := round.GroupCount()
+	if groupCount <= 0 {
+		return nil, NewInvalidParameterError("round " + round.Name + " has no groups")
+	}
+
+	if len(players) > round.PlayerCount {
+		return nil, NewNotAllowedError(fmt.Sprintf("round %s has room for %d players, got %d", round.Name, round.PlayerCount, len(players)))
+	}
+
+	if len(players) < round.PlayerCount && !allowUnderfilledGroups {
+		return nil, NewNotAllowedError(fmt.Sprintf("round %s requires %d players, got %d", round.Name, round.PlayerCount, len(players)))
+	}
+
+	if round.Type == RoundTypeSwiss {
+		return drawSwissPool(round, players), nil
+	}
+
+	if round.Type.IsElimination() {
+		return drawBracket(round, players), nil
+	}
+
+	seededPlayers := SeedPlayers(players, groupCount, strategy, seed)
+
+	groups := make([]Group, groupCount)
+	for i := range groups {
+		groups[i] = Group{
+			Name:     GroupName(i),
+			RoundId:  round.Id,
+			Position: i,
+			Players:  seededPlayers[i],
+			Matches:  make([]Match, 0, round.MatchCount),
+		}
+
+		if round.Type == RoundTypeRoundRobin {
+			groups[i].Matches = RoundRobinMatches(groups[i].Players, round.RoundRobinCycles)
+			continue
+		}
+
+		for position := 0; position < round.MatchCount; position++ {
+			groups[i].Matches = append(groups[i].Matches, Match{
+				Position:   position,
+				Placements: make([]Placement, 0),
+			})
+		}
+	}
+
+	return groups, nil
+}
+
+// GroupName returns the display name of the group at the given position (Group A, Group B, ..., Group AA)
+func GroupName(position int) string {
+	letters := ""
+	for n := position + 1; n > 0; n = (n - 1) / 26 {
+		letters = string(rune('A'+(n-1)%26)) + letters
+	}
+	return "Group " + letters
+}
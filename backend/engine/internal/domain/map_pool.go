@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"math/rand"
+	"slices"
+)
+
+// MapAssignmentStrategy defines how the maps of a pool are assigned to generated matches
+type MapAssignmentStrategy string
+
+const (
+	// MapRotation plays the maps of the pool in their listed order, starting over after the last map
+	MapRotation MapAssignmentStrategy = "ROTATION"
+	// MapRandom draws maps at random with a stored seed and repeats a map within a group only once all maps were played
+	MapRandom MapAssignmentStrategy = "RANDOM"
+	// MapSeedPick leaves the map open and lets the players pick in seed order.
+	// In matches between a subset of the group the highest seed picks, otherwise the pick rotates through the group.
+	MapSeedPick MapAssignmentStrategy = "SEED_PICK"
+)
+
+// MapPool holds the maps a tournament or round is played on
+type MapPool struct {
+	Maps     []string              `json:"maps"`
+	Strategy MapAssignmentStrategy `json:"strategy"`
+	Seed     int64                 `json:"seed"`
+}
+
+// MapPoolFor returns the map pool of the round, falling back to the tournament's pool.
+// It returns nil if neither defines one.
+func (t *Tournament) MapPoolFor(round *Round) *MapPool {
+	if round.MapPool != nil {
+		return round.MapPool
+	}
+	return t.MapPool
+}
+
+// AssignMaps fills the map or the map picker of every given match of the group that has neither yet.
+// Matches that are already decided, such as byes, are skipped. Maps already assigned to other matches
+// of the group are taken into account, so matches generated later continue the rotation.
+func (p *MapPool) AssignMaps(group *Group, matches []Match) {
+	if p == nil || len(p.Maps) == 0 {
+		return
+	}
+
+	played := make([]string, 0, len(group.Matches))
+	picks := 0
+	for _, match := range group.Matches {
+		if match.MapName != "" {
+			played = append(played, match.MapName)
+		}
+		if match.MapName != "" || match.MapPickerId != "" {
+			picks++
+		}
+	}
+
+	for i := range matches {
+		match := &matches[i]
+		if match.MapName != "" || match.MapPickerId != "" || len(match.Placements) > 0 {
+			continue
+		}
+
+		switch p.Strategy {
+		case MapSeedPick:
+			match.MapPickerId = mapPicker(group, match, picks)
+		case MapRandom:
+			match.MapName = p.randomMap(group, played)
+		default:
+			match.MapName = p.Maps[len(played)%len(p.Maps)]
+		}
+
+		if match.MapName != "" {
+			played = append(played, match.MapName)
+		}
+		picks++
+	}
+}
+
+// AssignSeatedMaps assigns maps to bracket matches once both of their players are seated.
+// With seed picks, the picker of a later bracket match is only known after its players advanced into it.
+func (p *MapPool) AssignSeatedMaps(bracket *Group, matches []*Match) {
+	for _, match := range matches {
+		if len(match.Players) < 2 {
+			continue
+		}
+		seated := []Match{*match}
+		p.AssignMaps(bracket, seated)
+		match.MapName = seated[0].MapName
+		match.MapPickerId = seated[0].MapPickerId
+	}
+}
+
+// AssignGroupMaps assigns maps to all matches of the given groups
+func (p *MapPool) AssignGroupMaps(groups []Group) {
+	for i := range groups {
+		p.AssignMaps(&groups[i], groups[i].Matches)
+	}
+}
+
+// ValidateMapPick ensures that the map of the match may still be changed and belongs to the pool
+func (p *MapPool) ValidateMapPick(match *Match, mapName string) error {
+	if len(match.Placements) > 0 {
+		return NewNotAllowedError("the map cannot be changed after placements were recorded")
+	}
+	if p != nil && len(p.Maps) > 0 && !slices.Contains(p.Maps, mapName) {
+		return NewInvalidParameterError("map " + mapName + " is not part of the map pool")
+	}
+	return nil
+}
+
+// randomMap draws a map that has not been played in the current cycle of the group
+func (p *MapPool) randomMap(group *Group, played []string) string {
+	cycle := played[len(played)-len(played)%len(p.Maps):]
+
+	available := make([]string, 0, len(p.Maps))
+	for _, name := range p.Maps {
+		if !slices.Contains(cycle, name) {
+			available = append(available, name)
+		}
+	}
+	if len(available) == 0 {
+		available = p.Maps
+	}
+
+	random := rand.New(rand.NewSource(p.Seed + int64(group.Position)*int64(len(p.Maps)+1) + int64(len(played))))
+	return available[random.Intn(len(available))]
+}
+
+// mapPicker returns the player who picks the map of the match.
+// Bracket matches whose players are not known yet have no picker.
+func mapPicker(group *Group, match *Match, picks int) string {
+	if len(match.Players) > 0 {
+		return match.Players[0].Id
+	}
+	if match.Bracket != "" || len(group.Players) == 0 {
+		return ""
+	}
+	return group.Players[picks%len(group.Players)].Id
+}
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"testing"
+)
+
+func mapPoolTestGroup(matchCount int) *Group {
+	group := &Group{
+		Players: []Player{{Id: "a"}, {Id: "b"}, {Id: "c"}},
+	}
+	for position := 0; position < matchCount; position++ {
+		group.Matches = append(group.Matches, Match{Position: position})
+	}
+	return group
+}
+
+func TestMapPoolAssignMaps(t *testing.T) {
+	maps := []string{"Mario Circuit", "Rainbow Road", "Koopa Beach"}
+
+	t.Run("rotation continues with matches generated later", func(t *testing.T) {
+		pool := &MapPool{Maps: maps, Strategy: MapRotation}
+		group := mapPoolTestGroup(2)
+		pool.AssignMaps(group, group.Matches)
+
+		added := []Match{{Position: 2}, {Position: 3}}
+		pool.AssignMaps(group, added)
+		group.Matches = append(group.Matches, added...)
+
+		expected := []string{"Mario Circuit", "Rainbow Road", "Koopa Beach", "Mario Circuit"}
+		for i, match := range group.Matches {
+			if match.MapName != expected[i] {
+				t.Errorf("Expected %s for match %d, got %s", expected[i], i, match.MapName)
+			}
+		}
+	})
+
+	t.Run("random does not repeat a map before the pool is exhausted", func(t *testing.T) {
+		pool := &MapPool{Maps: maps, Strategy: MapRandom, Seed: 42}
+		group := mapPoolTestGroup(6)
+		pool.AssignMaps(group, group.Matches)
+
+		for cycle := 0; cycle < 2; cycle++ {
+			seen := make(map[string]bool)
+			for _, match := range group.Matches[cycle*3 : cycle*3+3] {
+				if seen[match.MapName] {
+					t.Errorf("Expected no repeated map within cycle %d, got %s twice", cycle, match.MapName)
+				}
+				seen[match.MapName] = true
+			}
+		}
+	})
+
+	t.Run("seed pick rotates the picker through the group", func(t *testing.T) {
+		pool := &MapPool{Maps: maps, Strategy: MapSeedPick}
+		group := mapPoolTestGroup(4)
+		pool.AssignMaps(group, group.Matches)
+
+		expected := []string{"a", "b", "c", "a"}
+		for i, match := range group.Matches {
+			if match.MapName != "" || match.MapPickerId != expected[i] {
+				t.Errorf("Expected %s to pick the map of match %d, got %q (map %q)", expected[i], i, match.MapPickerId, match.MapName)
+			}
+		}
+	})
+
+	t.Run("seed pick lets the higher seed of an advanced bracket match pick", func(t *testing.T) {
+		pool := &MapPool{Maps: maps, Strategy: MapSeedPick}
+		round := &Round{Type: RoundTypeSingleElimination, PlayerCount: 4, GroupSize: 2}
+		bracket := bracketTestDraw(t, round, "1", "2", "3", "4")
+		pool.AssignMaps(bracket, bracket.Matches)
+
+		final := &bracket.Matches[2]
+		if final.MapPickerId != "" {
+			t.Fatalf("Expected no picker before the finalists are known, got %s", final.MapPickerId)
+		}
+
+		for position, winnerId := range []string{"4", "2"} {
+			match := &bracket.Matches[position]
+			for _, player := range match.Players {
+				placement := 2
+				if player.Id == winnerId {
+					placement = 1
+				}
+				match.Placements = append(match.Placements, Placement{PlayerId: player.Id, Placement: placement})
+			}
+			changed, _ := bracket.AdvanceBracket(round)
+			pool.AssignSeatedMaps(bracket, changed)
+
+			if position == 0 && final.MapPickerId != "" {
+				t.Fatalf("Expected no picker while only one finalist is known, got %s", final.MapPickerId)
+			}
+		}
+
+		// Seed 2 is ranked above seed 4 and therefore picks
+		if final.MapPickerId != "2" {
+			t.Errorf("Expected 2 to pick the map of the final, got %q", final.MapPickerId)
+		}
+	})
+}
+
+func TestMapPoolValidateMapPick(t *testing.T) {
+	pool := &MapPool{Maps: []string{"Mario Circuit"}}
+
+	if err := pool.ValidateMapPick(&Match{}, "Rainbow Road"); !IsInvalidParameter(err) {
+		t.Errorf("Expected maps outside the pool to be rejected, got %v", err)
+	}
+	if err := pool.ValidateMapPick(&Match{Placements: []Placement{{PlayerId: "a", Placement: 1}}}, "Mario Circuit"); !IsNotAllowed(err) {
+		t.Errorf("Expected decided matches to be locked, got %v", err)
+	}
+	if err := pool.ValidateMapPick(&Match{}, "Mario Circuit"); err != nil {
+		t.Errorf("Expected map pick to be allowed, got %v", err)
+	}
+}
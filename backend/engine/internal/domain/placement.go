@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"fmt"
+)
+
+// ValidatePlacements checks that the placements form a full finishing order of the match:
+// every player of the match is placed exactly once and the placements run from 1 to the number of players without gaps.
+func (g *Group) ValidatePlacements(match *Match, placements []Placement) error {
+	players := g.MatchPlayers(match)
+	if len(placements) != len(players) {
+		return NewInvalidParameterError(fmt.Sprintf("expected placements for %d players, got %d", len(players), len(placements)))
+	}
+
+	isMatchPlayer := make(map[string]bool, len(players))
+	for _, player := range players {
+		isMatchPlayer[player.Id] = true
+	}
+
+	seenPlayers := make(map[string]bool, len(placements))
+	seenPlacements := make(map[int]bool, len(placements))
+
+	for _, placement := range placements {
+		if !isMatchPlayer[placement.PlayerId] {
+			return NewInvalidParameterError("player " + placement.PlayerId + " does not take part in the match")
+		}
+
+		if seenPlayers[placement.PlayerId] {
+			return NewInvalidParameterError("player " + placement.PlayerId + " is placed more than once")
+		}
+		seenPlayers[placement.PlayerId] = true
+
+		if placement.Placement < 1 || placement.Placement > len(placements) {
+			return NewInvalidParameterError(fmt.Sprintf("placement %d is out of range 1-%d", placement.Placement, len(placements)))
+		}
+
+		if seenPlacements[placement.Placement] {
+			return NewInvalidParameterError(fmt.Sprintf("placement %d is assigned more than once", placement.Placement))
+		}
+		seenPlacements[placement.Placement] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestValidatePlacements(t *testing.T) {
+	group := &Group{
+		Players: []Player{{Id: "a"}, {Id: "b"}, {Id: "c"}},
+	}
+	headToHead := &Match{Players: []Player{{Id: "a"}, {Id: "b"}}}
+
+	tests := []struct {
+		name       string
+		match      *Match
+		placements []Placement
+		valid      bool
+	}{
+		{"full finishing order", &Match{}, []Placement{{PlayerId: "b", Placement: 1}, {PlayerId: "c", Placement: 2}, {PlayerId: "a", Placement: 3}}, true},
+		{"finishing order of the match players", headToHead, []Placement{{PlayerId: "b", Placement: 1}, {PlayerId: "a", Placement: 2}}, true},
+		{"duplicate player", &Match{}, []Placement{{PlayerId: "a", Placement: 1}, {PlayerId: "a", Placement: 2}, {PlayerId: "c", Placement: 3}}, false},
+		{"duplicate placement", &Match{}, []Placement{{PlayerId: "a", Placement: 1}, {PlayerId: "b", Placement: 1}, {PlayerId: "c", Placement: 3}}, false},
+		{"gap in placements", &Match{}, []Placement{{PlayerId: "a", Placement: 1}, {PlayerId: "b", Placement: 2}, {PlayerId: "c", Placement: 4}}, false},
+		{"placement below one", &Match{}, []Placement{{PlayerId: "a", Placement: 0}, {PlayerId: "b", Placement: 1}, {PlayerId: "c", Placement: 2}}, false},
+		{"player outside the group", &Match{}, []Placement{{PlayerId: "a", Placement: 1}, {PlayerId: "b", Placement: 2}, {PlayerId: "x", Placement: 3}}, false},
+		{"group player outside the match", headToHead, []Placement{{PlayerId: "a", Placement: 1}, {PlayerId: "c", Placement: 2}}, false},
+		{"incomplete order", &Match{}, []Placement{{PlayerId: "a", Placement: 1}, {PlayerId: "b", Placement: 2}}, false},
+		{"too many placements", headToHead, []Placement{{PlayerId: "a", Placement: 1}, {PlayerId: "b", Placement: 2}, {PlayerId: "c", Placement: 3}}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := group.ValidatePlacements(test.match, test.placements)
+			if test.valid && err != nil {
+				t.Errorf("Expected placements to be valid, got %v", err)
+			}
+			if !test.valid && !IsInvalidParameter(err) {
+				t.Errorf("Expected an invalid parameter error, got %v", err)
+			}
+		})
+	}
+}
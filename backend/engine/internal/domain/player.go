@@ -1,8 +1,23 @@
 package domain
 
+// PlayerStatus describes whether a player takes part in the main event
+type PlayerStatus string
+
+const (
+	// PlayerRegistered is a player who signed up before qualifying was closed
+	PlayerRegistered PlayerStatus = "REGISTERED"
+	// PlayerQualified is a player who made the cut for the main event
+	PlayerQualified PlayerStatus = "QUALIFIED"
+	// PlayerWaitlisted is a player who missed the cut but may be promoted when a qualified player withdraws
+	PlayerWaitlisted PlayerStatus = "WAITLISTED"
+	// PlayerEliminated is a player who missed the cut and cannot be promoted
+	PlayerEliminated PlayerStatus = "ELIMINATED"
+)
+
 type Player struct {
 	// Table: players
-	Id           string `json:"id"`
-	Name         string `json:"name"`
-	TournamentId string `json:"tournamentId"`
+	Id           string       `json:"id"`
+	Name         string       `json:"name"`
+	TournamentId string       `json:"tournamentId"`
+	Status       PlayerStatus `json:"status"`
 }
@@ -0,0 +1,68 @@
+package domain
+
+// PointsSchemeType identifies how placements are converted into points
+type PointsSchemeType string
+
+const (
+	// PointsMarioKart awards 15, 12, 10, 9, ... 1 points
+	PointsMarioKart PointsSchemeType = "MARIO_KART"
+	// PointsF1 awards 25, 18, 15, 12, 10, 8, 6, 4, 2, 1 points
+	PointsF1 PointsSchemeType = "F1"
+	// PointsLinear awards one point per beaten player plus one, so last place receives a single point
+	PointsLinear PointsSchemeType = "LINEAR"
+	// PointsCustom awards points from a user defined table
+	PointsCustom PointsSchemeType = "CUSTOM"
+)
+
+var (
+	marioKartPoints = []int{15, 12, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1}
+	f1Points        = []int{25, 18, 15, 12, 10, 8, 6, 4, 2, 1}
+)
+
+// PointsScheme converts match placements into points
+type PointsScheme struct {
+	Type  PointsSchemeType `json:"type"`
+	Table []int            `json:"table,omitempty"`
+}
+
+// DefaultPointsScheme is used when neither the tournament nor the round defines a scheme
+var DefaultPointsScheme = PointsScheme{Type: PointsLinear}
+
+// Points returns the points awarded for the given placement in a match with the given number of participants
+func (p PointsScheme) Points(placement int, participants int) int {
+	if placement < 1 {
+		return 0
+	}
+
+	switch p.Type {
+	case PointsMarioKart:
+		return pointsFromTable(marioKartPoints, placement)
+	case PointsF1:
+		return pointsFromTable(f1Points, placement)
+	case PointsCustom:
+		return pointsFromTable(p.Table, placement)
+	default:
+		if placement > participants {
+			return 0
+		}
+		return participants - placement + 1
+	}
+}
+
+func pointsFromTable(table []int, placement int) int {
+	if placement > len(table) {
+		return 0
+	}
+	return table[placement-1]
+}
+
+// PointsSchemeFor returns the points scheme of the round, falling back to the tournament's scheme
+func (t *Tournament) PointsSchemeFor(round *Round) PointsScheme {
+	if round.PointsScheme != nil {
+		return *round.PointsScheme
+	}
+	if t.PointsScheme.Type == "" {
+		return DefaultPointsScheme
+	}
+	return t.PointsScheme
+}
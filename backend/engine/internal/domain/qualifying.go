@@ -1,5 +1,72 @@
 package domain
 
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// NoQualifyingTime marks a player who has not set a qualifying time yet
+const NoQualifyingTime = -1
+
+// QualifyingMode defines how the attempts of a player are combined into their qualifying time
+type QualifyingMode string
+
+const (
+	// QualifyingBest ranks players by their fastest attempt
+	QualifyingBest QualifyingMode = "BEST"
+	// QualifyingAverage ranks players by the mean of their attempts. A player who has used fewer attempts than
+	// the attempt limit is ranked by the mean of the attempts submitted so far.
+	QualifyingAverage QualifyingMode = "AVERAGE"
+)
+
+// QualifyingConfig defines how qualifying is run for a tournament
+type QualifyingConfig struct {
+	// AttemptLimit is the maximum number of attempts per player, 0 means unlimited
+	AttemptLimit int            `json:"attemptLimit"`
+	Mode         QualifyingMode `json:"mode"`
+	// OpensAt and ClosesAt schedule the qualifying window
+	OpensAt  *time.Time `json:"opensAt,omitempty"`
+	ClosesAt *time.Time `json:"closesAt,omitempty"`
+	// OpenedAt is set when qualifying was opened manually
+	OpenedAt *time.Time `json:"openedAt,omitempty"`
+	// ClosedAt is set when qualifying was closed and the main event players were determined
+	ClosedAt *time.Time `json:"closedAt,omitempty"`
+}
+
+// CheckAttemptAllowed ensures that a player with the given number of attempts may submit another one
+func (c QualifyingConfig) CheckAttemptAllowed(attempts int) error {
+	if c.AttemptLimit > 0 && attempts >= c.AttemptLimit {
+		return NewNotAllowedError(fmt.Sprintf("attempt limit of %d reached", c.AttemptLimit))
+	}
+	return nil
+}
+
+// QualifyingTime combines the attempt times of a player into their qualifying time according to the mode.
+// It returns NoQualifyingTime if the player has no attempts.
+func (c QualifyingConfig) QualifyingTime(times []int) int {
+	if len(times) == 0 {
+		return NoQualifyingTime
+	}
+
+	if c.Mode == QualifyingAverage {
+		sum := 0
+		for _, attempt := range times {
+			sum += attempt
+		}
+		return int(math.Round(float64(sum) / float64(len(times))))
+	}
+
+	best := times[0]
+	for _, attempt := range times[1:] {
+		best = min(best, attempt)
+	}
+	return best
+}
+
 type Qualifying struct {
 	TournamentId string              `json:"tournament_id"`
 	Players      []*QualifyingPlayer `json:"players"`
@@ -11,4 +78,82 @@ type QualifyingPlayer struct {
 	Position   int    `json:"position"`
 	SignupDate string `json:"signup_date"`
 	Time       int    `json:"time"`
+	Attempts   int    `json:"attempts"`
+}
+
+type QualifyingAttempt struct {
+	// Table: qualifying_attempts
+	Id           string    `json:"id"`
+	TournamentId string    `json:"tournament_id"`
+	PlayerId     string    `json:"player_id"`
+	Time         int       `json:"time"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// RankPlayers orders the players by their qualifying time and sets their positions.
+// Players with the same time share a position and keep their order, players without a time are ranked last.
+func (q *Qualifying) RankPlayers() {
+	sort.SliceStable(q.Players, func(i, j int) bool {
+		a, b := q.Players[i].Time, q.Players[j].Time
+		if (a < 0) != (b < 0) {
+			return b < 0
+		}
+		return a < b
+	})
+
+	for i, player := range q.Players {
+		player.Position = i + 1
+		if i > 0 && player.Time == q.Players[i-1].Time {
+			player.Position = q.Players[i-1].Position
+		}
+	}
+}
+
+// FindPlayer returns the qualifying entry of the given player
+func (q *Qualifying) FindPlayer(playerId string) (*QualifyingPlayer, error) {
+	for _, player := range q.Players {
+		if player.PlayerId == playerId {
+			return player, nil
+		}
+	}
+	return nil, NewNotFoundError("player not found in qualifying")
+}
+
+var lapTimePattern = regexp.MustCompile(`^(\d+):([0-5]\d)\.(\d{3})$`)
+
+// maxQualifyingTime is the longest qualifying time in milliseconds, as times are stored in an INT column
+const maxQualifyingTime = math.MaxInt32
+
+// ParseQualifyingTime converts a time given in milliseconds ("83456") or as m:ss.SSS ("1:23.456") into milliseconds
+func ParseQualifyingTime(value string) (int, error) {
+	if milliseconds, err := strconv.Atoi(value); err == nil {
+		if milliseconds <= 0 {
+			return 0, NewInvalidParameterError("qualifying time must be greater than 0")
+		}
+		if milliseconds > maxQualifyingTime {
+			return 0, NewInvalidParameterError("qualifying time is out of range")
+		}
+		return milliseconds, nil
+	}
+
+	parts := lapTimePattern.FindStringSubmatch(value)
+	if parts == nil {
+		return 0, NewInvalidParameterError("qualifying time must be given in milliseconds or as m:ss.SSS")
+	}
+
+	minutes, err := strconv.Atoi(parts[1])
+	if err != nil || minutes > maxQualifyingTime/60000 {
+		return 0, NewInvalidParameterError("qualifying time is out of range")
+	}
+	seconds, _ := strconv.Atoi(parts[2])
+	milliseconds, _ := strconv.Atoi(parts[3])
+
+	total := (minutes*60+seconds)*1000 + milliseconds
+	if total <= 0 {
+		return 0, NewInvalidParameterError("qualifying time must be greater than 0")
+	}
+	if total > maxQualifyingTime {
+		return 0, NewInvalidParameterError("qualifying time is out of range")
+	}
+	return total, nil
 }
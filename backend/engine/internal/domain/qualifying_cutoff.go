@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// MainEventSize returns the number of players who take part in the main event
+func (t *Tournament) MainEventSize() int {
+	if t.PlayerCount > 0 || len(t.Rounds) == 0 {
+		return t.PlayerCount
+	}
+	return t.Rounds[0].PlayerCount
+}
+
+// MainEventPlayers returns the players who take part in the main event.
+// Before qualifying is closed every registered player takes part.
+func (t *Tournament) MainEventPlayers() []Player {
+	if t.Qualifying.ClosedAt == nil {
+		return t.Players
+	}
+
+	players := make([]Player, 0, t.MainEventSize())
+	for _, player := range t.Players {
+		if player.Status == PlayerQualified {
+			players = append(players, player)
+		}
+	}
+	return players
+}
+
+// CloseQualifying takes the best ranked players into the main event and marks the rest with the overflow status.
+// The ranked players must be ordered by their qualifying position.
+func (t *Tournament) CloseQualifying(ranked []Player, overflow PlayerStatus, closedAt time.Time) error {
+	if t.Status != StatusDraft {
+		return NewConflictError("qualifying can only be closed before the tournament starts")
+	}
+
+	if t.Qualifying.ClosedAt != nil {
+		return NewConflictError("qualifying is already closed")
+	}
+
+	if overflow != PlayerWaitlisted && overflow != PlayerEliminated {
+		return NewInvalidParameterError(fmt.Sprintf("players who miss the cut cannot be marked as %s", overflow))
+	}
+
+	statuses := make(map[string]PlayerStatus, len(ranked))
+	for i, player := range ranked {
+		if i < t.MainEventSize() {
+			statuses[player.Id] = PlayerQualified
+		} else {
+			statuses[player.Id] = overflow
+		}
+	}
+
+	for i := range t.Players {
+		if status, ok := statuses[t.Players[i].Id]; ok {
+			t.Players[i].Status = status
+		} else {
+			t.Players[i].Status = overflow
+		}
+	}
+
+	t.Qualifying.ClosedAt = &closedAt
+	return nil
+}
+
+// CheckSignupAllowed ensures that players may still sign up. Once qualifying is closed the main event players
+// are determined, so a later signup could never take part.
+func (t *Tournament) CheckSignupAllowed() error {
+	if t.Qualifying.ClosedAt != nil {
+		return NewConflictError("players cannot sign up after qualifying was closed")
+	}
+	return nil
+}
+
+// WithdrawPlayer removes a player from the tournament
+func (t *Tournament) WithdrawPlayer(playerId string) error {
+	for i := range t.Players {
+		if t.Players[i].Id == playerId {
+			t.Players = append(t.Players[:i], t.Players[i+1:]...)
+			return nil
+		}
+	}
+	return NewNotFoundError("player not found in tournament")
+}
+
+// PromoteWaitlistedPlayer moves the best ranked waitlisted player into the main event if a spot is free.
+// It returns nil if no player was promoted.
+func (t *Tournament) PromoteWaitlistedPlayer(ranked []Player) *Player {
+	if t.Status != StatusDraft || t.Qualifying.ClosedAt == nil {
+		return nil
+	}
+
+	if len(t.MainEventPlayers()) >= t.MainEventSize() {
+		return nil
+	}
+
+	for _, candidate := range ranked {
+		for i := range t.Players {
+			if t.Players[i].Id == candidate.Id && t.Players[i].Status == PlayerWaitlisted {
+				t.Players[i].Status = PlayerQualified
+				return &t.Players[i]
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func cutoffTestTournament() (*Tournament, []Player) {
+	tournament := &Tournament{
+		Status:      StatusDraft,
+		PlayerCount: 2,
+		Players: []Player{
+			{Id: "a", Status: PlayerRegistered},
+			{Id: "b", Status: PlayerRegistered},
+			{Id: "c", Status: PlayerRegistered},
+			{Id: "d", Status: PlayerRegistered},
+		},
+	}
+	ranked := []Player{{Id: "c"}, {Id: "a"}, {Id: "d"}, {Id: "b"}}
+	return tournament, ranked
+}
+
+func TestTournamentCloseQualifying(t *testing.T) {
+	tournament, ranked := cutoffTestTournament()
+
+	if err := tournament.CloseQualifying(ranked, PlayerWaitlisted, time.Now()); err != nil {
+		t.Fatalf("Expected qualifying to close, got %v", err)
+	}
+
+	expected := map[string]PlayerStatus{"a": PlayerQualified, "b": PlayerWaitlisted, "c": PlayerQualified, "d": PlayerWaitlisted}
+	for _, player := range tournament.Players {
+		if player.Status != expected[player.Id] {
+			t.Errorf("Expected player %s to be %s, got %s", player.Id, expected[player.Id], player.Status)
+		}
+	}
+
+	if len(tournament.MainEventPlayers()) != 2 {
+		t.Errorf("Expected 2 main event players, got %d", len(tournament.MainEventPlayers()))
+	}
+
+	if err := tournament.CloseQualifying(ranked, PlayerWaitlisted, time.Now()); !IsConflict(err) {
+		t.Errorf("Expected closing twice to be rejected with a conflict, got %v", err)
+	}
+}
+
+func TestTournamentPromoteWaitlistedPlayer(t *testing.T) {
+	tournament, ranked := cutoffTestTournament()
+	if err := tournament.CloseQualifying(ranked, PlayerWaitlisted, time.Now()); err != nil {
+		t.Fatalf("Expected qualifying to close, got %v", err)
+	}
+
+	if promoted := tournament.PromoteWaitlistedPlayer(ranked); promoted != nil {
+		t.Errorf("Expected no promotion while the main event is full, got %s", promoted.Id)
+	}
+
+	if err := tournament.CheckSignupAllowed(); !IsConflict(err) {
+		t.Errorf("Expected signups after closing to be rejected with a conflict, got %v", err)
+	}
+
+	if err := tournament.WithdrawPlayer("c"); err != nil {
+		t.Fatalf("Expected player c to withdraw, got %v", err)
+	}
+	if err := tournament.WithdrawPlayer("c"); !IsNotFound(err) {
+		t.Errorf("Expected withdrawing twice to fail with not found, got %v", err)
+	}
+	ranked = []Player{{Id: "a"}, {Id: "d"}, {Id: "b"}}
+
+	promoted := tournament.PromoteWaitlistedPlayer(ranked)
+	if promoted == nil || promoted.Id != "d" {
+		t.Fatalf("Expected best ranked waitlisted player d to be promoted, got %v", promoted)
+	}
+
+	if promoted := tournament.PromoteWaitlistedPlayer(ranked); promoted != nil {
+		t.Errorf("Expected no further promotion, got %s", promoted.Id)
+	}
+}
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestParseQualifyingTime(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected int
+		valid    bool
+	}{
+		{"milliseconds", "83456", 83456, true},
+		{"lap time", "1:23.456", 83456, true},
+		{"lap time below a minute", "0:59.999", 59999, true},
+		{"lap time over an hour", "61:00.000", 3660000, true},
+		{"zero milliseconds", "0", 0, false},
+		{"negative milliseconds", "-83456", 0, false},
+		{"zero lap time", "0:00.000", 0, false},
+		{"negative lap time", "-1:23.456", 0, false},
+		{"empty", "", 0, false},
+		{"seconds only", "23.456", 0, false},
+		{"seconds above 59", "1:60.000", 0, false},
+		{"single digit seconds", "1:3.456", 0, false},
+		{"missing milliseconds", "1:23", 0, false},
+		{"two digit milliseconds", "1:23.45", 0, false},
+		{"surrounding whitespace", " 83456 ", 0, false},
+		{"letters", "1:2a.456", 0, false},
+		{"minutes out of range", "99999999999999999999:01.000", 0, false},
+		{"minutes overflowing the stored time", "999999999999999:00.000", 0, false},
+		{"longest storable time", "2147483647", 2147483647, true},
+		{"milliseconds overflowing the stored time", "2147483648", 0, false},
+		{"lap time overflowing the stored time", "35791:23.648", 0, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			milliseconds, err := ParseQualifyingTime(test.value)
+			if test.valid {
+				if err != nil {
+					t.Fatalf("Expected %q to be parsed, got %v", test.value, err)
+				}
+				if milliseconds != test.expected {
+					t.Errorf("Expected %q to be %d ms, got %d", test.value, test.expected, milliseconds)
+				}
+				return
+			}
+			if !IsInvalidParameter(err) {
+				t.Errorf("Expected %q to be rejected with an invalid parameter error, got %d, %v", test.value, milliseconds, err)
+			}
+		})
+	}
+}
+
+func TestQualifyingTime(t *testing.T) {
+	tests := []struct {
+		name     string
+		mode     QualifyingMode
+		times    []int
+		expected int
+	}{
+		{"best without attempts", QualifyingBest, nil, NoQualifyingTime},
+		{"best attempt", QualifyingBest, []int{83456, 81234, 82000}, 81234},
+		{"average without attempts", QualifyingAverage, nil, NoQualifyingTime},
+		{"average of all attempts", QualifyingAverage, []int{81000, 82000, 83000}, 82000},
+		{"average rounded to milliseconds", QualifyingAverage, []int{81000, 81001}, 81001},
+		// A player below the attempt limit is ranked by the mean of the attempts submitted so far
+		{"average of fewer attempts than the limit", QualifyingAverage, []int{80000}, 80000},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := QualifyingConfig{Mode: test.mode, AttemptLimit: 3}
+			if time := config.QualifyingTime(test.times); time != test.expected {
+				t.Errorf("Expected %d, got %d", test.expected, time)
+			}
+		})
+	}
+}
+
+func TestQualifyingRankPlayers(t *testing.T) {
+	qualifying := &Qualifying{Players: []*QualifyingPlayer{
+		{PlayerId: "a", Time: NoQualifyingTime},
+		{PlayerId: "b", Time: 82000},
+		{PlayerId: "c", Time: 81000},
+		{PlayerId: "d", Time: 82000},
+	}}
+	qualifying.RankPlayers()
+
+	expected := []struct {
+		playerId string
+		position int
+	}{{"c", 1}, {"b", 2}, {"d", 2}, {"a", 4}}
+	for i, player := range qualifying.Players {
+		if player.PlayerId != expected[i].playerId || player.Position != expected[i].position {
+			t.Errorf("Expected %s at position %d, got %s at %d", expected[i].playerId, expected[i].position, player.PlayerId, player.Position)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"time"
+)
+
+// QualifyingWindow is the state of the qualifying window published to clients
+type QualifyingWindow struct {
+	TournamentId string     `json:"tournamentId"`
+	Open         bool       `json:"open"`
+	OpensAt      *time.Time `json:"opensAt,omitempty"`
+	ClosesAt     *time.Time `json:"closesAt,omitempty"`
+}
+
+// IsOpen reports whether qualifying times may be submitted at the given time.
+// Qualifying is open once it was opened manually or its scheduled opening time has passed,
+// and stays open until it is closed or its scheduled closing time has passed.
+// Without a scheduled opening time qualifying is open from the start.
+func (c QualifyingConfig) IsOpen(now time.Time) bool {
+	if c.ClosedAt != nil {
+		return false
+	}
+
+	if c.ClosesAt != nil && !now.Before(*c.ClosesAt) {
+		return false
+	}
+
+	if c.OpenedAt != nil {
+		return true
+	}
+
+	return c.OpensAt == nil || !now.Before(*c.OpensAt)
+}
+
+// CheckSubmissionAllowed ensures that qualifying times may be submitted at the given time
+func (c QualifyingConfig) CheckSubmissionAllowed(now time.Time) error {
+	if !c.IsOpen(now) {
+		return NewNotAllowedError("qualifying is not open")
+	}
+	return nil
+}
+
+// OpenQualifying opens the qualifying window before its scheduled opening time
+func (t *Tournament) OpenQualifying(now time.Time) error {
+	if t.Status != StatusDraft {
+		return NewConflictError("qualifying can only be opened before the tournament starts")
+	}
+
+	if t.Qualifying.ClosedAt != nil || (t.Qualifying.ClosesAt != nil && !now.Before(*t.Qualifying.ClosesAt)) {
+		return NewConflictError("qualifying is already closed")
+	}
+
+	if t.Qualifying.IsOpen(now) {
+		return NewConflictError("qualifying is already open")
+	}
+
+	t.Qualifying.OpenedAt = &now
+	return nil
+}
+
+// QualifyingWindow returns the state of the qualifying window at the given time
+func (t *Tournament) QualifyingWindow(now time.Time) QualifyingWindow {
+	return QualifyingWindow{
+		TournamentId: t.Id,
+		Open:         t.Qualifying.IsOpen(now),
+		OpensAt:      t.Qualifying.OpensAt,
+		ClosesAt:     t.Qualifying.ClosesAt,
+	}
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQualifyingConfigIsOpen(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	before := now.Add(-time.Hour)
+	after := now.Add(time.Hour)
+
+	cases := []struct {
+		name     string
+		config   QualifyingConfig
+		expected bool
+	}{
+		{"no window", QualifyingConfig{}, true},
+		{"closing time only", QualifyingConfig{ClosesAt: &after}, true},
+		{"closing time only and ended", QualifyingConfig{ClosesAt: &before}, false},
+		{"no window and closed manually", QualifyingConfig{ClosedAt: &before}, false},
+		{"scheduled and running", QualifyingConfig{OpensAt: &before, ClosesAt: &after}, true},
+		{"scheduled in the future", QualifyingConfig{OpensAt: &after}, false},
+		{"scheduled and ended", QualifyingConfig{OpensAt: &before, ClosesAt: &before}, false},
+		{"opened manually", QualifyingConfig{OpensAt: &after, OpenedAt: &before}, true},
+		{"closed manually", QualifyingConfig{OpensAt: &before, ClosedAt: &before}, false},
+	}
+
+	for _, c := range cases {
+		if open := c.config.IsOpen(now); open != c.expected {
+			t.Errorf("%s: expected open to be %t, got %t", c.name, c.expected, open)
+		}
+	}
+}
+
+func TestTournamentOpenQualifying(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	opensAt := now.Add(time.Hour)
+	tournament := &Tournament{Status: StatusDraft, Qualifying: QualifyingConfig{OpensAt: &opensAt}}
+
+	if err := tournament.Qualifying.CheckSubmissionAllowed(now); !IsNotAllowed(err) {
+		t.Errorf("Expected submission to be rejected before qualifying opens, got %v", err)
+	}
+
+	if err := tournament.OpenQualifying(now); err != nil {
+		t.Fatalf("Expected qualifying to open, got %v", err)
+	}
+
+	if err := tournament.Qualifying.CheckSubmissionAllowed(now); err != nil {
+		t.Errorf("Expected submission to be allowed, got %v", err)
+	}
+
+	if err := tournament.OpenQualifying(now); !IsConflict(err) {
+		t.Errorf("Expected opening twice to be rejected with a conflict, got %v", err)
+	}
+}
+
+func TestTournamentOpenQualifyingWithoutWindow(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	tournament := &Tournament{Status: StatusDraft}
+
+	if err := tournament.Qualifying.CheckSubmissionAllowed(now); err != nil {
+		t.Errorf("Expected submission to be allowed without a window, got %v", err)
+	}
+
+	if err := tournament.OpenQualifying(now); !IsConflict(err) {
+		t.Errorf("Expected opening an unscheduled qualifying to be rejected with a conflict, got %v", err)
+	}
+}
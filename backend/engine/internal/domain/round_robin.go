@@ -0,0 +1,55 @@
+package domain
+
+// RoundRobinMatches generates the 1v1 matches in which every player meets every other player once per cycle.
+// The pairings follow the circle method: one player stays fixed while the others rotate around them.
+// With an odd number of players, the player paired with the empty seat has a bye in that stage and no match is created.
+// Every other cycle swaps the order of the players of each pairing.
+func RoundRobinMatches(players []Player, cycles int) []Match {
+	if cycles < 1 {
+		cycles = 1
+	}
+
+	seats := make([]*Player, 0, len(players)+1)
+	for i := range players {
+		seats = append(seats, &players[i])
+	}
+	if len(seats)%2 == 1 {
+		seats = append(seats, nil)
+	}
+
+	matches := make([]Match, 0)
+	if len(seats) < 2 {
+		return matches
+	}
+
+	stagesPerCycle := len(seats) - 1
+	for cycle := 0; cycle < cycles; cycle++ {
+		rotation := append(make([]*Player, 0, len(seats)), seats...)
+
+		for stage := 0; stage < stagesPerCycle; stage++ {
+			for i := 0; i < len(rotation)/2; i++ {
+				home, away := rotation[i], rotation[len(rotation)-1-i]
+				if home == nil || away == nil {
+					continue
+				}
+				if cycle%2 == 1 {
+					home, away = away, home
+				}
+
+				matches = append(matches, Match{
+					Position:   len(matches),
+					Stage:      cycle*stagesPerCycle + stage,
+					Players:    []Player{*home, *away},
+					Placements: make([]Placement, 0),
+				})
+			}
+
+			// Keep the first seat fixed and rotate all other seats by one
+			last := rotation[len(rotation)-1]
+			copy(rotation[2:], rotation[1:len(rotation)-1])
+			rotation[1] = last
+		}
+	}
+
+	return matches
+}
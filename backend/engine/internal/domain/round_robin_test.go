@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestRoundRobinMatches(t *testing.T) {
+	t.Run("every player meets every other player once per cycle", func(t *testing.T) {
+		players := []Player{{Id: "a"}, {Id: "b"}, {Id: "c"}, {Id: "d"}}
+		matches := RoundRobinMatches(players, 2)
+
+		if len(matches) != 12 {
+			t.Fatalf("Expected 12 matches for two cycles of 4 players, got %d", len(matches))
+		}
+
+		pairings := make(map[string]int)
+		for _, match := range matches {
+			pairings[tablePlayers(match)]++
+		}
+		for _, pairing := range []string{"ab", "ac", "ad", "bc", "bd", "cd"} {
+			reversed := string(pairing[1]) + string(pairing[0])
+			if pairings[pairing] != 1 || pairings[reversed] != 1 {
+				t.Errorf("Expected %s to be played once in each order, got %d and %d", pairing, pairings[pairing], pairings[reversed])
+			}
+		}
+
+		if matches[len(matches)-1].Stage != 5 {
+			t.Errorf("Expected 6 stages, last stage is %d", matches[len(matches)-1].Stage)
+		}
+	})
+
+	t.Run("odd group sizes give one player a bye per stage", func(t *testing.T) {
+		players := []Player{{Id: "a"}, {Id: "b"}, {Id: "c"}}
+		matches := RoundRobinMatches(players, 1)
+
+		if len(matches) != 3 {
+			t.Fatalf("Expected 3 matches for 3 players, got %d", len(matches))
+		}
+
+		stages := make(map[int]int)
+		for _, match := range matches {
+			stages[match.Stage]++
+		}
+		if len(stages) != 3 {
+			t.Errorf("Expected 3 stages with one match each, got %v", stages)
+		}
+	})
+}
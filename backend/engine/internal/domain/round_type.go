@@ -0,0 +1,57 @@
+package domain
+
+// RoundType defines how the players of a round are grouped into matches
+type RoundType string
+
+const (
+	// RoundTypeGroup splits the players into fixed groups that play all matches together
+	RoundTypeGroup RoundType = "GROUP"
+	// RoundTypeSwiss pairs players with similar cumulative points for a fixed number of stages
+	RoundTypeSwiss RoundType = "SWISS"
+	// RoundTypeRoundRobin lets every player of a group meet every other player of the group in 1v1 matches
+	RoundTypeRoundRobin RoundType = "ROUND_ROBIN"
+	// RoundTypeSingleElimination plays a knockout bracket of 1v1 matches
+	RoundTypeSingleElimination RoundType = "SINGLE_ELIMINATION"
+	// RoundTypeDoubleElimination plays a knockout bracket in which players are eliminated after their second loss
+	RoundTypeDoubleElimination RoundType = "DOUBLE_ELIMINATION"
+)
+
+// IsElimination reports whether the round is played as a knockout bracket
+func (t RoundType) IsElimination() bool {
+	return t == RoundTypeSingleElimination || t == RoundTypeDoubleElimination
+}
+
+// IsSinglePool reports whether all players of the round play in a single group
+func (t RoundType) IsSinglePool() bool {
+	return t == RoundTypeSwiss || t.IsElimination()
+}
+
+// MatchPlayers returns the players taking part in the match.
+// Matches without explicit participants are played by the whole group.
+func (g *Group) MatchPlayers(match *Match) []Player {
+	if len(match.Players) > 0 {
+		return match.Players
+	}
+	return g.Players
+}
+
+// StageCount returns the number of stages the matches of the group have been generated for
+func (g *Group) StageCount() int {
+	count := 0
+	for _, match := range g.Matches {
+		if match.Stage+1 > count {
+			count = match.Stage + 1
+		}
+	}
+	return count
+}
+
+// isComplete reports whether every match of the group has placements
+func (g *Group) isComplete() bool {
+	for _, match := range g.Matches {
+		if len(match.Placements) == 0 {
+			return false
+		}
+	}
+	return true
+}
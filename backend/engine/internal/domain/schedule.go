@@ -0,0 +1,255 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// ScheduleConfig holds the parameters used to lay out the matches of a tournament in time
+type ScheduleConfig struct {
+	StartsAt             *time.Time `json:"startsAt,omitempty"`
+	MatchDurationMinutes int        `json:"matchDurationMinutes"`
+	// StationCount limits how many groups can play at the same time. Zero means no limit.
+	StationCount int `json:"stationCount"`
+}
+
+// IsConfigured reports whether a schedule can be laid out
+func (c ScheduleConfig) IsConfigured() bool {
+	return c.StartsAt != nil && c.MatchDurationMinutes > 0
+}
+
+// ScheduleDelay postpones a slot of a round and every slot after it
+type ScheduleDelay struct {
+	// Table: schedule_delays
+	Id        string    `json:"id"`
+	RoundId   string    `json:"roundId"`
+	Slot      int       `json:"slot"`
+	Minutes   int       `json:"minutes"`
+	Reason    string    `json:"reason,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Schedule is the time plan of all rounds of a tournament
+type Schedule struct {
+	TournamentId string         `json:"tournamentId"`
+	Slots        []ScheduleSlot `json:"slots"`
+}
+
+// ScheduleSlot is a period of time in which a set of groups of a round play at the same time
+type ScheduleSlot struct {
+	RoundId      string           `json:"roundId"`
+	RoundName    string           `json:"roundName"`
+	Slot         int              `json:"slot"`
+	StartsAt     time.Time        `json:"startsAt"`
+	EndsAt       time.Time        `json:"endsAt"`
+	DelayMinutes int              `json:"delayMinutes"`
+	Groups       []ScheduledGroup `json:"groups"`
+}
+
+// ScheduledGroup is a group playing in a slot. GroupId is empty until the round has been drawn.
+// Stations holds the numbers of the stations the group plays on, which is empty if no station is free for it.
+type ScheduledGroup struct {
+	GroupId    string `json:"groupId,omitempty"`
+	GroupName  string `json:"groupName"`
+	Stations   []int  `json:"stations"`
+	MatchCount int    `json:"matchCount"`
+	// tables is the number of stations the group occupies
+	tables int
+}
+
+// BuildSchedule lays out the groups of every round in consecutive slots, so that no more than
+// ConcurrentGroupCount groups of a round, and no more groups than there are stations, play at the same time.
+// Every group occupies one station and plays its matches back to back. Swiss and elimination rounds play
+// in a single pool that occupies one station per table. Delays shift their slot and all later slots.
+// A group keeps the station it has been allocated, the other groups of a slot are planned on the remaining
+// stations in number order. The stations are expected to be ordered by number.
+func (t *Tournament) BuildSchedule(delays []ScheduleDelay, stations []Station) (*Schedule, error) {
+	if !t.Schedule.IsConfigured() {
+		return nil, NewConflictError("tournament has no schedule")
+	}
+
+	matchDuration := time.Duration(t.Schedule.MatchDurationMinutes) * time.Minute
+	start := *t.Schedule.StartsAt
+	slots := make([]ScheduleSlot, 0)
+
+	for i := range t.Rounds {
+		round := &t.Rounds[i]
+		concurrent := t.concurrentGroups(round)
+
+		var groups [][]ScheduledGroup
+		if round.Type.IsSinglePool() {
+			groups = [][]ScheduledGroup{{scheduledPool(round, concurrent)}}
+		} else {
+			groups = chunkGroups(scheduledGroups(round), concurrent)
+		}
+
+		for slot, slotGroups := range groups {
+			delay := delayMinutes(delays, round.Id, slot)
+			start = start.Add(time.Duration(delay) * time.Minute)
+
+			assignScheduledStations(slotGroups, stations)
+			matchCount := 0
+			for j := range slotGroups {
+				matchCount = max(matchCount, slotGroups[j].MatchCount)
+			}
+
+			end := start.Add(time.Duration(matchCount) * matchDuration)
+			slots = append(slots, ScheduleSlot{
+				RoundId:      round.Id,
+				RoundName:    round.Name,
+				Slot:         slot,
+				StartsAt:     start,
+				EndsAt:       end,
+				DelayMinutes: delay,
+				Groups:       slotGroups,
+			})
+			start = end
+		}
+	}
+
+	return &Schedule{TournamentId: t.Id, Slots: slots}, nil
+}
+
+// SlotCount returns the number of slots the round is split into
+func (t *Tournament) SlotCount(round *Round) int {
+	if round.Type.IsSinglePool() {
+		return 1
+	}
+	concurrent := t.concurrentGroups(round)
+	return (round.GroupCount() + concurrent - 1) / concurrent
+}
+
+// CheckScheduleDelay ensures that the delay targets an existing slot
+func (t *Tournament) CheckScheduleDelay(delay ScheduleDelay) error {
+	round, err := t.FindRound(delay.RoundId)
+	if err != nil {
+		return err
+	}
+	if delay.Slot < 0 || delay.Slot >= t.SlotCount(round) {
+		return NewInvalidParameterError(fmt.Sprintf("round %s has no slot %d", round.Name, delay.Slot))
+	}
+	return nil
+}
+
+// concurrentGroups returns how many groups of the round can play at the same time
+func (t *Tournament) concurrentGroups(round *Round) int {
+	concurrent := max(round.ConcurrentGroupCount, 1)
+	if t.Schedule.StationCount > 0 {
+		concurrent = min(concurrent, t.Schedule.StationCount)
+	}
+	return concurrent
+}
+
+// scheduledGroups returns the groups of a round, or placeholders named like the future groups if it is not drawn yet
+func scheduledGroups(round *Round) []ScheduledGroup {
+	if len(round.Groups) > 0 {
+		groups := make([]ScheduledGroup, 0, len(round.Groups))
+		for _, group := range round.Groups {
+			groups = append(groups, ScheduledGroup{
+				GroupId:    group.Id,
+				GroupName:  group.Name,
+				MatchCount: len(group.Matches),
+				tables:     1,
+			})
+		}
+		return groups
+	}
+
+	matchCount := round.MatchCount
+	if round.Type == RoundTypeRoundRobin {
+		matchCount = round.RoundRobinMatchCount()
+	}
+
+	groups := make([]ScheduledGroup, round.GroupCount())
+	for i := range groups {
+		groups[i] = ScheduledGroup{GroupName: GroupName(i), MatchCount: matchCount, tables: 1}
+	}
+	return groups
+}
+
+// scheduledPool returns the single pool of a Swiss or elimination round.
+// Tables that do not fit onto the available stations play one after another.
+func scheduledPool(round *Round, concurrent int) ScheduledGroup {
+	tables := max(round.GroupCount(), 1)
+	stations := min(tables, concurrent)
+	waves := (tables + stations - 1) / stations
+
+	stages := round.MatchCount
+	if round.Type.IsElimination() {
+		stages = int(math.Ceil(math.Log2(float64(max(round.PlayerCount, 2)))))
+		if round.Type == RoundTypeDoubleElimination {
+			stages = 2*stages + 1
+		}
+	}
+
+	name := SwissPoolName
+	if round.Type.IsElimination() {
+		name = BracketName
+	}
+
+	pool := ScheduledGroup{
+		GroupName:  name,
+		MatchCount: stages * waves,
+		tables:     stations,
+	}
+	if len(round.Groups) > 0 {
+		pool.GroupId = round.Groups[0].Id
+		pool.GroupName = round.Groups[0].Name
+	}
+	return pool
+}
+
+// assignScheduledStations names the stations the groups of a slot play on. A group keeps the station it has
+// been allocated, the other groups get the remaining stations in number order until no station is left.
+func assignScheduledStations(groups []ScheduledGroup, stations []Station) {
+	allocated := make(map[string]int, len(stations))
+	for _, station := range stations {
+		if !station.IsFree() {
+			allocated[station.GroupId] = station.Number
+		}
+	}
+
+	used := make(map[int]bool, len(stations))
+	for i := range groups {
+		groups[i].Stations = make([]int, 0, groups[i].tables)
+		if number, ok := allocated[groups[i].GroupId]; ok && groups[i].GroupId != "" {
+			groups[i].Stations = append(groups[i].Stations, number)
+			used[number] = true
+		}
+	}
+
+	next := 0
+	for i := range groups {
+		for len(groups[i].Stations) < groups[i].tables {
+			for next < len(stations) && used[stations[next].Number] {
+				next++
+			}
+			if next == len(stations) {
+				return
+			}
+			groups[i].Stations = append(groups[i].Stations, stations[next].Number)
+			used[stations[next].Number] = true
+		}
+	}
+}
+
+// chunkGroups splits the groups into slots of at most size groups
+func chunkGroups(groups []ScheduledGroup, size int) [][]ScheduledGroup {
+	chunks := make([][]ScheduledGroup, 0, (len(groups)+size-1)/size)
+	for start := 0; start < len(groups); start += size {
+		chunks = append(chunks, groups[start:min(start+size, len(groups))])
+	}
+	return chunks
+}
+
+// delayMinutes sums all delays of a slot
+func delayMinutes(delays []ScheduleDelay, roundId string, slot int) int {
+	minutes := 0
+	for _, delay := range delays {
+		if delay.RoundId == roundId && delay.Slot == slot {
+			minutes += delay.Minutes
+		}
+	}
+	return minutes
+}
@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestTournamentBuildSchedule(t *testing.T) {
+	startsAt := time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+	tournament := &Tournament{
+		Schedule: ScheduleConfig{StartsAt: &startsAt, MatchDurationMinutes: 10, StationCount: 3},
+		Rounds: []Round{
+			{Id: "groups", Type: RoundTypeGroup, PlayerCount: 16, GroupSize: 4, MatchCount: 3, ConcurrentGroupCount: 4},
+			{Id: "final", Type: RoundTypeGroup, PlayerCount: 4, GroupSize: 4, MatchCount: 2, ConcurrentGroupCount: 1},
+		},
+	}
+	stations := []Station{{Id: "s1", Number: 1}, {Id: "s2", Number: 2}, {Id: "s5", Number: 5}}
+
+	t.Run("never plays more groups at once than stations are available", func(t *testing.T) {
+		schedule, err := tournament.BuildSchedule(nil, stations)
+		if err != nil {
+			t.Fatalf("Expected schedule, got %v", err)
+		}
+
+		expected := []struct {
+			groups   int
+			startsAt string
+			endsAt   string
+		}{
+			{3, "10:00", "10:30"},
+			{1, "10:30", "11:00"},
+			{1, "11:00", "11:20"},
+		}
+		if len(schedule.Slots) != len(expected) {
+			t.Fatalf("Expected %d slots, got %d", len(expected), len(schedule.Slots))
+		}
+		for i, slot := range schedule.Slots {
+			if len(slot.Groups) != expected[i].groups {
+				t.Errorf("Expected %d groups in slot %d, got %d", expected[i].groups, i, len(slot.Groups))
+			}
+			if slot.StartsAt.Format("15:04") != expected[i].startsAt || slot.EndsAt.Format("15:04") != expected[i].endsAt {
+				t.Errorf("Expected slot %d from %s to %s, got %s to %s", i, expected[i].startsAt, expected[i].endsAt, slot.StartsAt.Format("15:04"), slot.EndsAt.Format("15:04"))
+			}
+		}
+		if stations := schedule.Slots[0].Groups[2].Stations; len(stations) != 1 || stations[0] != 5 {
+			t.Errorf("Expected the third group to play on station 5, got %v", stations)
+		}
+	})
+
+	t.Run("delays shift all later slots", func(t *testing.T) {
+		schedule, err := tournament.BuildSchedule([]ScheduleDelay{{RoundId: "groups", Slot: 1, Minutes: 15}}, stations)
+		if err != nil {
+			t.Fatalf("Expected schedule, got %v", err)
+		}
+
+		if schedule.Slots[0].StartsAt.Format("15:04") != "10:00" {
+			t.Errorf("Expected the first slot to stay at 10:00, got %s", schedule.Slots[0].StartsAt.Format("15:04"))
+		}
+		if schedule.Slots[1].StartsAt.Format("15:04") != "10:45" || schedule.Slots[1].DelayMinutes != 15 {
+			t.Errorf("Expected the delayed slot to start at 10:45, got %s", schedule.Slots[1].StartsAt.Format("15:04"))
+		}
+		if schedule.Slots[2].StartsAt.Format("15:04") != "11:15" {
+			t.Errorf("Expected the final to start at 11:15, got %s", schedule.Slots[2].StartsAt.Format("15:04"))
+		}
+	})
+
+	t.Run("groups keep their allocated stations", func(t *testing.T) {
+		drawn := *tournament
+		drawn.Rounds = []Round{{Id: "groups", Type: RoundTypeGroup, PlayerCount: 8, GroupSize: 2, MatchCount: 1, ConcurrentGroupCount: 3, Groups: []Group{
+			{Id: "group-a", Name: "Group A", Matches: []Match{{}}},
+			{Id: "group-b", Name: "Group B", Matches: []Match{{}}},
+			{Id: "group-c", Name: "Group C", Matches: []Match{{}}},
+			{Id: "group-d", Name: "Group D", Matches: []Match{{}}},
+		}}}
+		allocated := []Station{{Id: "s1", Number: 1}, {Id: "s2", Number: 2, GroupId: "group-a"}}
+
+		schedule, err := drawn.BuildSchedule(nil, allocated)
+		if err != nil {
+			t.Fatalf("Expected schedule, got %v", err)
+		}
+
+		expected := [][]int{{2}, {1}, {}}
+		for i, group := range schedule.Slots[0].Groups {
+			if !slices.Equal(group.Stations, expected[i]) {
+				t.Errorf("Expected %s to play on stations %v, got %v", group.GroupName, expected[i], group.Stations)
+			}
+		}
+	})
+
+	t.Run("rejects delays of unknown slots", func(t *testing.T) {
+		if err := tournament.CheckScheduleDelay(ScheduleDelay{RoundId: "groups", Slot: 2, Minutes: 5}); !IsInvalidParameter(err) {
+			t.Errorf("Expected invalid parameter error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"math/rand"
+)
+
+// SeedingStrategy defines how ranked players are distributed into the groups of a round
+type SeedingStrategy string
+
+const (
+	// SeedingSnake distributes players in serpentine order (A, B, C, C, B, A, ...)
+	SeedingSnake SeedingStrategy = "SNAKE"
+	// SeedingSequential fills the groups one after another in rank order
+	SeedingSequential SeedingStrategy = "SEQUENTIAL"
+	// SeedingRandom shuffles the players with a stored seed before filling the groups
+	SeedingRandom SeedingStrategy = "RANDOM"
+)
+
+// SeedPlayers distributes the players, ordered by rank, into groupCount groups.
+// Group sizes never differ by more than one, so underfilled rounds stay balanced.
+func SeedPlayers(players []Player, groupCount int, strategy SeedingStrategy, seed int64) [][]Player {
+	groups := make([][]Player, groupCount)
+	if groupCount <= 0 {
+		return groups
+	}
+
+	sizes := balancedSizes(len(players), groupCount)
+	for i := range sizes {
+		groups[i] = make([]Player, 0, sizes[i])
+	}
+
+	switch strategy {
+	case SeedingSequential:
+		fillSequential(groups, sizes, players)
+	case SeedingRandom:
+		shuffled := append(make([]Player, 0, len(players)), players...)
+		random := rand.New(rand.NewSource(seed))
+		random.Shuffle(len(shuffled), func(i, j int) {
+			shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+		})
+		fillSequential(groups, sizes, shuffled)
+	default:
+		fillSnake(groups, sizes, players)
+	}
+
+	return groups
+}
+
+// balancedSizes splits count items into groupCount groups whose sizes differ by at most one, larger groups first
+func balancedSizes(count int, groupCount int) []int {
+	sizes := make([]int, groupCount)
+	for i := range sizes {
+		sizes[i] = count / groupCount
+		if i < count%groupCount {
+			sizes[i]++
+		}
+	}
+	return sizes
+}
+
+func fillSequential(groups [][]Player, sizes []int, players []Player) {
+	group := 0
+	for _, player := range players {
+		for len(groups[group]) == sizes[group] {
+			group++
+		}
+		groups[group] = append(groups[group], player)
+	}
+}
+
+func fillSnake(groups [][]Player, sizes []int, players []Player) {
+	group, direction := 0, 1
+	for _, player := range players {
+		for len(groups[group]) == sizes[group] {
+			group, direction = nextSnakeGroup(group, direction, len(groups))
+		}
+		groups[group] = append(groups[group], player)
+		group, direction = nextSnakeGroup(group, direction, len(groups))
+	}
+}
+
+func nextSnakeGroup(group int, direction int, groupCount int) (int, int) {
+	next := group + direction
+	if next < 0 || next >= groupCount {
+		return group, -direction
+	}
+	return next, direction
+}
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func seedingTestPlayers(count int) []Player {
+	players := make([]Player, count)
+	for i := range players {
+		players[i] = Player{Id: strconv.Itoa(i + 1)}
+	}
+	return players
+}
+
+func groupPlayerIds(groups [][]Player) []string {
+	ids := make([]string, len(groups))
+	for i, group := range groups {
+		groupIds := make([]string, 0, len(group))
+		for _, player := range group {
+			groupIds = append(groupIds, player.Id)
+		}
+		ids[i] = strings.Join(groupIds, ",")
+	}
+	return ids
+}
+
+func TestSeedPlayers(t *testing.T) {
+	t.Run("snake", func(t *testing.T) {
+		groups := groupPlayerIds(SeedPlayers(seedingTestPlayers(6), 3, SeedingSnake, 0))
+
+		expected := []string{"1,6", "2,5", "3,4"}
+		for i := range expected {
+			if groups[i] != expected[i] {
+				t.Errorf("Expected group %d to be %v, got %v", i, expected[i], groups[i])
+			}
+		}
+	})
+
+	t.Run("sequential", func(t *testing.T) {
+		groups := groupPlayerIds(SeedPlayers(seedingTestPlayers(6), 3, SeedingSequential, 0))
+
+		expected := []string{"1,2", "3,4", "5,6"}
+		for i := range expected {
+			if groups[i] != expected[i] {
+				t.Errorf("Expected group %d to be %v, got %v", i, expected[i], groups[i])
+			}
+		}
+	})
+
+	t.Run("random is reproducible with the same seed", func(t *testing.T) {
+		first := groupPlayerIds(SeedPlayers(seedingTestPlayers(8), 2, SeedingRandom, 42))
+		second := groupPlayerIds(SeedPlayers(seedingTestPlayers(8), 2, SeedingRandom, 42))
+
+		for i := range first {
+			if first[i] != second[i] {
+				t.Errorf("Expected group %d to be %v, got %v", i, first[i], second[i])
+			}
+		}
+	})
+
+	t.Run("underfilled groups stay balanced", func(t *testing.T) {
+		groups := SeedPlayers(seedingTestPlayers(7), 3, SeedingSnake, 0)
+
+		for i, expectedSize := range []int{3, 2, 2} {
+			if len(groups[i]) != expectedSize {
+				t.Errorf("Expected group %d to have %d players, got %d", i, expectedSize, len(groups[i]))
+			}
+		}
+	})
+}
@@ -0,0 +1,193 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+)
+
+// Standing is the accumulated result of a player within a group
+type Standing struct {
+	Position      int    `json:"position"`
+	PlayerId      string `json:"playerId"`
+	PlayerName    string `json:"playerName"`
+	Points        int    `json:"points"`
+	Wins          int    `json:"wins"`
+	MatchesPlayed int    `json:"matchesPlayed"`
+	Placements    []int  `json:"placements"`
+	// Buchholz is the sum of the points of all opponents the player met
+	Buchholz int `json:"buchholz"`
+	// SonnebornBerger is the sum of the points of all opponents the player finished ahead of
+	SonnebornBerger int `json:"sonnebornBerger"`
+}
+
+// bestPlacement returns the best single placement of the player, ranking players without placements last
+func (s *Standing) bestPlacement() int {
+	best := int(^uint(0) >> 1)
+	for _, placement := range s.Placements {
+		if placement < best {
+			best = placement
+		}
+	}
+	return best
+}
+
+// TieBreak explains which rule ranked a player ahead of another player with equal points
+type TieBreak struct {
+	PlayerId   string     `json:"playerId"`
+	OpponentId string     `json:"opponentId"`
+	Points     int        `json:"points"`
+	TieBreaker TieBreaker `json:"tieBreaker"`
+}
+
+// GroupStandings is the ranking of all players of a group
+type GroupStandings struct {
+	RoundId   string     `json:"roundId"`
+	GroupId   string     `json:"groupId"`
+	Standings []Standing `json:"standings"`
+	TieBreaks []TieBreak `json:"tieBreaks"`
+}
+
+// CalculateStandings sums the points of every player across all matches of the group
+// and resolves equal points with the given tie-breaker chain.
+// A bye only counts as a match played, it awards no points, win or placement.
+func CalculateStandings(group *Group, scheme PointsScheme, tieBreakers TieBreakerChain) *GroupStandings {
+	standings := make([]Standing, 0, len(group.Players))
+	indexByPlayer := make(map[string]int, len(group.Players))
+
+	for _, player := range group.Players {
+		indexByPlayer[player.Id] = len(standings)
+		standings = append(standings, Standing{
+			PlayerId:   player.Id,
+			PlayerName: player.Name,
+			Placements: make([]int, 0, len(group.Matches)),
+		})
+	}
+
+	for _, match := range group.Matches {
+		for _, placement := range match.Placements {
+			index, ok := indexByPlayer[placement.PlayerId]
+			if !ok {
+				continue
+			}
+			standing := &standings[index]
+			if match.IsBye() {
+				standing.MatchesPlayed++
+				continue
+			}
+			standing.Points += scheme.Points(placement.Placement, len(match.Placements))
+			standing.MatchesPlayed++
+			standing.Placements = append(standing.Placements, placement.Placement)
+			if placement.Placement == 1 {
+				standing.Wins++
+			}
+		}
+	}
+
+	calculateOpponentScores(standings, indexByPlayer, group.Matches)
+
+	sort.SliceStable(standings, func(i, j int) bool {
+		return standings[i].Points > standings[j].Points
+	})
+
+	tieBreaks := make([]TieBreak, 0)
+	for start := 0; start < len(standings); {
+		end := start + 1
+		for end < len(standings) && standings[end].Points == standings[start].Points {
+			end++
+		}
+		if end-start > 1 {
+			tieBreaks = append(tieBreaks, resolveTie(standings[start:end], group.Matches, tieBreakers)...)
+		}
+		start = end
+	}
+
+	for i := range standings {
+		standings[i].Position = i + 1
+	}
+
+	return &GroupStandings{
+		RoundId:   group.RoundId,
+		GroupId:   group.Id,
+		Standings: standings,
+		TieBreaks: tieBreaks,
+	}
+}
+
+// calculateOpponentScores sums the final points of the opponents of every player for the Buchholz
+// and Sonneborn-Berger tie-breakers
+func calculateOpponentScores(standings []Standing, indexByPlayer map[string]int, matches []Match) {
+	for _, match := range matches {
+		for _, a := range match.Placements {
+			playerIndex, ok := indexByPlayer[a.PlayerId]
+			if !ok {
+				continue
+			}
+			for _, b := range match.Placements {
+				opponentIndex, ok := indexByPlayer[b.PlayerId]
+				if !ok || a.PlayerId == b.PlayerId {
+					continue
+				}
+				standings[playerIndex].Buchholz += standings[opponentIndex].Points
+				if a.Placement < b.Placement {
+					standings[playerIndex].SonnebornBerger += standings[opponentIndex].Points
+				}
+			}
+		}
+	}
+}
+
+// resolveTie orders players with equal points and explains the rule that separated each adjacent pair
+func resolveTie(tied []Standing, matches []Match, tieBreakers TieBreakerChain) []TieBreak {
+	headToHead := headToHeadScores(tied, matches)
+
+	decide := func(a *Standing, b *Standing) (int, TieBreaker) {
+		for _, rule := range tieBreakers.Rules {
+			if result := tieBreakers.compare(rule, a, b, headToHead); result != 0 {
+				return result, rule
+			}
+		}
+		return strings.Compare(a.PlayerId, b.PlayerId), TieBreakCoinFlip
+	}
+
+	sort.SliceStable(tied, func(i, j int) bool {
+		result, _ := decide(&tied[i], &tied[j])
+		return result < 0
+	})
+
+	tieBreaks := make([]TieBreak, 0, len(tied)-1)
+	for i := 1; i < len(tied); i++ {
+		_, rule := decide(&tied[i-1], &tied[i])
+		tieBreaks = append(tieBreaks, TieBreak{
+			PlayerId:   tied[i-1].PlayerId,
+			OpponentId: tied[i].PlayerId,
+			Points:     tied[i].Points,
+			TieBreaker: rule,
+		})
+	}
+
+	return tieBreaks
+}
+
+// headToHeadScores counts how often each tied player finished ahead of another tied player
+func headToHeadScores(tied []Standing, matches []Match) map[string]int {
+	isTied := make(map[string]bool, len(tied))
+	for _, standing := range tied {
+		isTied[standing.PlayerId] = true
+	}
+
+	scores := make(map[string]int, len(tied))
+	for _, match := range matches {
+		for _, a := range match.Placements {
+			if !isTied[a.PlayerId] {
+				continue
+			}
+			for _, b := range match.Placements {
+				if isTied[b.PlayerId] && a.Placement < b.Placement {
+					scores[a.PlayerId]++
+				}
+			}
+		}
+	}
+
+	return scores
+}
@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"testing"
+)
+
+func standingsTestGroup(results ...[]string) *Group {
+	group := &Group{
+		Id: "group-1",
+		Players: []Player{
+			{Id: "a", Name: "Alice"},
+			{Id: "b", Name: "Bob"},
+			{Id: "c", Name: "Carol"},
+		},
+	}
+
+	for _, order := range results {
+		match := Match{}
+		for i, playerId := range order {
+			match.Placements = append(match.Placements, Placement{PlayerId: playerId, Placement: i + 1})
+		}
+		group.Matches = append(group.Matches, match)
+	}
+
+	return group
+}
+
+func TestCalculateStandings(t *testing.T) {
+	t.Run("sums points across matches", func(t *testing.T) {
+		group := standingsTestGroup([]string{"a", "b", "c"}, []string{"a", "c", "b"})
+
+		standings := CalculateStandings(group, PointsScheme{Type: PointsMarioKart}, TieBreakerChain{Rules: []TieBreaker{TieBreakCoinFlip}})
+
+		expected := []int{30, 22, 22}
+		for i, standing := range standings.Standings {
+			if standing.Points != expected[i] {
+				t.Errorf("Expected %d points at position %d, got %d", expected[i], i+1, standing.Points)
+			}
+		}
+		if standings.Standings[0].PlayerId != "a" {
+			t.Errorf("Expected a to be ranked first, got %s", standings.Standings[0].PlayerId)
+		}
+	})
+
+	t.Run("head-to-head separates equal points", func(t *testing.T) {
+		group := standingsTestGroup([]string{"a", "c", "b"}, []string{"b", "c", "a"}, []string{"b", "c", "a"})
+
+		standings := CalculateStandings(group, PointsScheme{Type: PointsCustom, Table: []int{3, 1, 0}}, TieBreakerChain{
+			Rules: []TieBreaker{TieBreakHeadToHead, TieBreakCoinFlip},
+		})
+
+		if standings.Standings[1].PlayerId != "c" || standings.Standings[2].PlayerId != "a" {
+			t.Fatalf("Expected c ahead of a, got %s and %s", standings.Standings[1].PlayerId, standings.Standings[2].PlayerId)
+		}
+		if len(standings.TieBreaks) != 1 || standings.TieBreaks[0].TieBreaker != TieBreakHeadToHead {
+			t.Errorf("Expected one head-to-head tie-break, got %+v", standings.TieBreaks)
+		}
+	})
+
+	t.Run("qualifying time is used when results are identical", func(t *testing.T) {
+		group := standingsTestGroup([]string{"a", "b", "c"}, []string{"b", "a", "c"})
+
+		standings := CalculateStandings(group, DefaultPointsScheme, TieBreakersFor(&Round{
+			TieBreakers: []TieBreaker{TieBreakMostWins, TieBreakQualifyingTime},
+		}, map[string]int{"a": 61000, "b": 60000}))
+
+		if standings.Standings[0].PlayerId != "b" {
+			t.Errorf("Expected b to be ranked first, got %s", standings.Standings[0].PlayerId)
+		}
+		if standings.TieBreaks[0].TieBreaker != TieBreakQualifyingTime {
+			t.Errorf("Expected qualifying time tie-break, got %s", standings.TieBreaks[0].TieBreaker)
+		}
+	})
+
+	t.Run("coin flip is deterministic for a seed", func(t *testing.T) {
+		group := standingsTestGroup()
+		chain := TieBreakersFor(&Round{TieBreakSeed: 7}, nil)
+
+		first := CalculateStandings(group, DefaultPointsScheme, chain)
+		second := CalculateStandings(group, DefaultPointsScheme, chain)
+
+		for i := range first.Standings {
+			if first.Standings[i].PlayerId != second.Standings[i].PlayerId {
+				t.Errorf("Expected identical order at position %d, got %s and %s", i+1, first.Standings[i].PlayerId, second.Standings[i].PlayerId)
+			}
+		}
+		for _, tieBreak := range first.TieBreaks {
+			if tieBreak.TieBreaker != TieBreakCoinFlip {
+				t.Errorf("Expected coin flip tie-break, got %s", tieBreak.TieBreaker)
+			}
+		}
+	})
+
+	t.Run("bye counts as a match played without points", func(t *testing.T) {
+		group := standingsTestGroup([]string{"a", "b"})
+		group.Matches = append(group.Matches, Match{
+			Players:    []Player{{Id: "c"}},
+			Placements: []Placement{{PlayerId: "c", Placement: 1}},
+		})
+
+		standings := CalculateStandings(group, PointsScheme{Type: PointsMarioKart}, TieBreakerChain{Rules: []TieBreaker{TieBreakCoinFlip}})
+
+		bye := standings.Standings[2]
+		if bye.PlayerId != "c" {
+			t.Fatalf("Expected the bye player c to be ranked last, got %s", bye.PlayerId)
+		}
+		if bye.Points != 0 || bye.Wins != 0 || len(bye.Placements) != 0 {
+			t.Errorf("Expected the bye to award no points, wins or placements, got %+v", bye)
+		}
+		if bye.MatchesPlayed != 1 {
+			t.Errorf("Expected the bye to count as a match played, got %d", bye.MatchesPlayed)
+		}
+	})
+}
@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"time"
+)
+
+// Station is a numbered setup (console or PC) a group plays its matches on
+type Station struct {
+	// Table: stations
+	Id           string `json:"id"`
+	TournamentId string `json:"tournamentId"`
+	Number       int    `json:"number"`
+	Name         string `json:"name,omitempty"`
+	// GroupId is the group currently playing on the station. It is empty if the station is free.
+	GroupId    string     `json:"groupId,omitempty"`
+	AssignedAt *time.Time `json:"assignedAt,omitempty"`
+}
+
+// IsFree reports whether no group is playing on the station
+func (s *Station) IsFree() bool {
+	return s.GroupId == ""
+}
+
+// StationAssignment announces that a group moves to or leaves a station
+type StationAssignment struct {
+	TournamentId  string `json:"tournamentId"`
+	RoundId       string `json:"roundId"`
+	GroupId       string `json:"groupId"`
+	GroupName     string `json:"groupName"`
+	StationId     string `json:"stationId"`
+	StationNumber int    `json:"stationNumber"`
+	StationName   string `json:"stationName,omitempty"`
+}
+
+// AllocateStations frees the stations of finished groups and assigns every running group without a station
+// to the free station with the lowest number. No more than ConcurrentGroupCount groups of a round
+// occupy stations at the same time; groups that do not fit wait for the next free station in group order.
+// Swiss and elimination rounds play in a single pool, which is finished once the round is complete.
+// The stations are expected to be ordered by number. The changed stations are updated in place.
+func (t *Tournament) AllocateStations(stations []Station, now time.Time) (assigned []StationAssignment, released []StationAssignment) {
+	assigned = make([]StationAssignment, 0)
+	released = make([]StationAssignment, 0)
+
+	stationByGroup := make(map[string]*Station, len(stations))
+	for i := range stations {
+		if !stations[i].IsFree() {
+			stationByGroup[stations[i].GroupId] = &stations[i]
+		}
+	}
+
+	for i := range t.Rounds {
+		round := &t.Rounds[i]
+		for j := range round.Groups {
+			group := &round.Groups[j]
+			station, ok := stationByGroup[group.Id]
+			if ok && round.isGroupFinished(group) {
+				released = append(released, newStationAssignment(t, round, group, station))
+				station.GroupId = ""
+				station.AssignedAt = nil
+				delete(stationByGroup, group.Id)
+			}
+		}
+	}
+
+	for i := range t.Rounds {
+		round := &t.Rounds[i]
+		playing := 0
+		for j := range round.Groups {
+			if _, ok := stationByGroup[round.Groups[j].Id]; ok {
+				playing++
+			}
+		}
+
+		for j := range round.Groups {
+			group := &round.Groups[j]
+			if _, ok := stationByGroup[group.Id]; ok || round.isGroupFinished(group) {
+				continue
+			}
+			if playing >= max(round.ConcurrentGroupCount, 1) {
+				break
+			}
+
+			station := firstFreeStation(stations)
+			if station == nil {
+				return assigned, released
+			}
+			station.GroupId = group.Id
+			station.AssignedAt = &now
+			stationByGroup[group.Id] = station
+			playing++
+			assigned = append(assigned, newStationAssignment(t, round, group, station))
+		}
+	}
+
+	return assigned, released
+}
+
+// isGroupFinished reports whether all matches of the group have been played
+func (r *Round) isGroupFinished(group *Group) bool {
+	if r.Type.IsSinglePool() {
+		return r.IsComplete()
+	}
+	return group.isComplete()
+}
+
+func firstFreeStation(stations []Station) *Station {
+	for i := range stations {
+		if stations[i].IsFree() {
+			return &stations[i]
+		}
+	}
+	return nil
+}
+
+func newStationAssignment(tournament *Tournament, round *Round, group *Group, station *Station) StationAssignment {
+	return StationAssignment{
+		TournamentId:  tournament.Id,
+		RoundId:       round.Id,
+		GroupId:       group.Id,
+		GroupName:     group.Name,
+		StationId:     station.Id,
+		StationNumber: station.Number,
+		StationName:   station.Name,
+	}
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTournamentAllocateStations(t *testing.T) {
+	played := Match{Placements: []Placement{{PlayerId: "a", Placement: 1}}}
+	tournament := &Tournament{
+		Rounds: []Round{{
+			Id:                   "round-1",
+			ConcurrentGroupCount: 2,
+			Groups: []Group{
+				{Id: "group-a", Name: "Group A", Matches: []Match{{}}},
+				{Id: "group-b", Name: "Group B", Matches: []Match{{}}},
+				{Id: "group-c", Name: "Group C", Matches: []Match{{}}},
+			},
+		}},
+	}
+	stations := []Station{{Id: "station-1", Number: 1}, {Id: "station-2", Number: 2}, {Id: "station-3", Number: 3}}
+
+	assigned, _ := tournament.AllocateStations(stations, time.Now())
+	if len(assigned) != 2 {
+		t.Fatalf("Expected 2 concurrent groups to be assigned, got %d", len(assigned))
+	}
+	if stations[0].GroupId != "group-a" || stations[1].GroupId != "group-b" || !stations[2].IsFree() {
+		t.Fatalf("Expected groups A and B on stations 1 and 2, got %+v", stations)
+	}
+
+	tournament.Rounds[0].Groups[0].Matches = []Match{played}
+	assigned, released := tournament.AllocateStations(stations, time.Now())
+
+	if len(released) != 1 || released[0].GroupId != "group-a" {
+		t.Errorf("Expected group A to release its station, got %+v", released)
+	}
+	if len(assigned) != 1 || assigned[0].GroupName != "Group C" || assigned[0].StationNumber != 1 {
+		t.Errorf("Expected group C to move to station 1, got %+v", assigned)
+	}
+}
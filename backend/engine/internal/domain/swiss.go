@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"sort"
+)
+
+// SwissPoolName is the name of the single group a Swiss round is played in
+const SwissPoolName = "Swiss"
+
+// drawSwissPool places all players, ordered by rank, into a single group and pairs the first stage
+func drawSwissPool(round *Round, players []Player) []Group {
+	pool := Group{
+		Name:     SwissPoolName,
+		RoundId:  round.Id,
+		Position: 0,
+		Players:  append(make([]Player, 0, len(players)), players...),
+		Matches:  make([]Match, 0),
+	}
+	pool.Matches = append(pool.Matches, PairSwissStage(round, &pool, DefaultPointsScheme)...)
+
+	return []Group{pool}
+}
+
+// PairSwissStage creates the matches of the next stage of a Swiss round.
+// Players are ordered by their cumulative points (then by seed) and seated at tables of GroupSize players,
+// avoiding players who already met where possible. If the players cannot be seated evenly,
+// the lowest ranked player without a previous bye receives a bye. The bye is recorded as a first place
+// so that the stage completes, but it awards no points.
+func PairSwissStage(round *Round, pool *Group, scheme PointsScheme) []Match {
+	stage := pool.StageCount()
+	if round.GroupSize <= 0 || len(pool.Players) == 0 {
+		return nil
+	}
+
+	points := make(map[string]int, len(pool.Players))
+	met := make(map[string]map[string]bool, len(pool.Players))
+	hadBye := make(map[string]bool)
+	for i := range pool.Matches {
+		match := &pool.Matches[i]
+		if !match.IsBye() {
+			for _, placement := range match.Placements {
+				points[placement.PlayerId] += scheme.Points(placement.Placement, len(match.Placements))
+			}
+		}
+
+		players := pool.MatchPlayers(match)
+		if len(players) == 1 {
+			hadBye[players[0].Id] = true
+		}
+		for _, a := range players {
+			for _, b := range players {
+				if a.Id == b.Id {
+					continue
+				}
+				if met[a.Id] == nil {
+					met[a.Id] = make(map[string]bool)
+				}
+				met[a.Id][b.Id] = true
+			}
+		}
+	}
+
+	remaining := append(make([]Player, 0, len(pool.Players)), pool.Players...)
+	sort.SliceStable(remaining, func(i, j int) bool {
+		return points[remaining[i].Id] > points[remaining[j].Id]
+	})
+
+	tableCount := (len(remaining) + round.GroupSize - 1) / round.GroupSize
+	sizes := balancedSizes(len(remaining), tableCount)
+
+	var byePlayer *Player
+	if sizes[len(sizes)-1] == 1 && len(remaining) > 1 {
+		byeIndex := len(remaining) - 1
+		for i := len(remaining) - 1; i >= 0; i-- {
+			if !hadBye[remaining[i].Id] {
+				byeIndex = i
+				break
+			}
+		}
+		bye := remaining[byeIndex]
+		byePlayer = &bye
+		remaining = append(remaining[:byeIndex], remaining[byeIndex+1:]...)
+		sizes = sizes[:len(sizes)-1]
+	}
+
+	matches := make([]Match, 0, tableCount)
+	for _, size := range sizes {
+		table := []Player{remaining[0]}
+		remaining = remaining[1:]
+
+		for len(table) < size {
+			index := 0
+			for candidate := range remaining {
+				if !hasMetAny(met, remaining[candidate], table) {
+					index = candidate
+					break
+				}
+			}
+			table = append(table, remaining[index])
+			remaining = append(remaining[:index], remaining[index+1:]...)
+		}
+
+		matches = append(matches, Match{
+			Position:   len(pool.Matches) + len(matches),
+			Stage:      stage,
+			Players:    table,
+			Placements: make([]Placement, 0),
+		})
+	}
+
+	if byePlayer != nil {
+		matches = append(matches, Match{
+			Position:   len(pool.Matches) + len(matches),
+			Stage:      stage,
+			Players:    []Player{*byePlayer},
+			Placements: []Placement{{PlayerId: byePlayer.Id, Placement: 1}},
+		})
+	}
+
+	return matches
+}
+
+// NeedsNextSwissStage reports whether the current stage of a Swiss pool is finished and further stages remain
+func (r *Round) NeedsNextSwissStage(pool *Group) bool {
+	return r.Type == RoundTypeSwiss && pool.isComplete() && pool.StageCount() < r.MatchCount
+}
+
+func hasMetAny(met map[string]map[string]bool, player Player, table []Player) bool {
+	for _, opponent := range table {
+		if met[player.Id][opponent.Id] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"testing"
+)
+
+func swissTestPool(round *Round, playerIds ...string) *Group {
+	players := make([]Player, 0, len(playerIds))
+	for _, playerId := range playerIds {
+		players = append(players, Player{Id: playerId})
+	}
+	groups, _ := DrawGroups(round, players, SeedingSnake, 0, true)
+	return &groups[0]
+}
+
+// recordWinners lets the first listed player of every match of the current stage win
+func recordWinners(pool *Group, winners ...string) {
+	isWinner := make(map[string]bool, len(winners))
+	for _, winner := range winners {
+		isWinner[winner] = true
+	}
+
+	for i := range pool.Matches {
+		match := &pool.Matches[i]
+		if len(match.Placements) > 0 {
+			continue
+		}
+		placement := 2
+		for _, player := range match.Players {
+			if isWinner[player.Id] {
+				match.Placements = append(match.Placements, Placement{PlayerId: player.Id, Placement: 1})
+			} else {
+				match.Placements = append(match.Placements, Placement{PlayerId: player.Id, Placement: placement})
+				placement++
+			}
+		}
+	}
+}
+
+func tablePlayers(match Match) string {
+	ids := ""
+	for _, player := range match.Players {
+		ids += player.Id
+	}
+	return ids
+}
+
+func TestPairSwissStage(t *testing.T) {
+	t.Run("pairs by points and avoids rematches", func(t *testing.T) {
+		round := &Round{Type: RoundTypeSwiss, PlayerCount: 4, GroupSize: 2, MatchCount: 3}
+		pool := swissTestPool(round, "a", "b", "c", "d")
+
+		if len(pool.Matches) != 2 || tablePlayers(pool.Matches[0]) != "ab" || tablePlayers(pool.Matches[1]) != "cd" {
+			t.Fatalf("Expected first stage ab and cd, got %+v", pool.Matches)
+		}
+
+		recordWinners(pool, "a", "c")
+		if !round.NeedsNextSwissStage(pool) {
+			t.Fatal("Expected a second stage to be needed")
+		}
+		pool.Matches = append(pool.Matches, PairSwissStage(round, pool, DefaultPointsScheme)...)
+		if tablePlayers(pool.Matches[2]) != "ac" || tablePlayers(pool.Matches[3]) != "bd" {
+			t.Fatalf("Expected second stage ac and bd, got %s and %s", tablePlayers(pool.Matches[2]), tablePlayers(pool.Matches[3]))
+		}
+
+		recordWinners(pool, "a", "b")
+		pool.Matches = append(pool.Matches, PairSwissStage(round, pool, DefaultPointsScheme)...)
+		if tablePlayers(pool.Matches[4]) != "ad" || tablePlayers(pool.Matches[5]) != "bc" {
+			t.Fatalf("Expected third stage ad and bc, got %s and %s", tablePlayers(pool.Matches[4]), tablePlayers(pool.Matches[5]))
+		}
+		if pool.Matches[4].Stage != 2 {
+			t.Errorf("Expected stage 2, got %d", pool.Matches[4].Stage)
+		}
+
+		recordWinners(pool, "a", "c")
+		if round.NeedsNextSwissStage(pool) || !(&Round{Type: RoundTypeSwiss, MatchCount: 3, Groups: []Group{*pool}}).IsComplete() {
+			t.Error("Expected the Swiss round to be complete after three stages")
+		}
+	})
+
+	t.Run("gives a bye to the lowest ranked player", func(t *testing.T) {
+		round := &Round{Type: RoundTypeSwiss, PlayerCount: 4, GroupSize: 2, MatchCount: 2}
+		pool := swissTestPool(round, "a", "b", "c")
+
+		bye := pool.Matches[len(pool.Matches)-1]
+		if tablePlayers(bye) != "c" || len(bye.Placements) != 1 {
+			t.Fatalf("Expected c to receive a bye, got %+v", bye)
+		}
+
+		recordWinners(pool, "a")
+		next := PairSwissStage(round, pool, DefaultPointsScheme)
+		if tablePlayers(next[len(next)-1]) != "b" {
+			t.Errorf("Expected b to receive the next bye, got %s", tablePlayers(next[len(next)-1]))
+		}
+	})
+}
+
+func TestCalculateStandingsBuchholz(t *testing.T) {
+	group := &Group{
+		Players: []Player{{Id: "a"}, {Id: "b"}, {Id: "c"}, {Id: "d"}},
+		Matches: []Match{
+			{Placements: []Placement{{PlayerId: "a", Placement: 1}, {PlayerId: "b", Placement: 2}}},
+			{Placements: []Placement{{PlayerId: "c", Placement: 1}, {PlayerId: "d", Placement: 2}}},
+			{Placements: []Placement{{PlayerId: "a", Placement: 1}, {PlayerId: "c", Placement: 2}}},
+			{Placements: []Placement{{PlayerId: "d", Placement: 1}, {PlayerId: "b", Placement: 2}}},
+		},
+	}
+
+	standings := CalculateStandings(group, DefaultPointsScheme, TieBreakersFor(&Round{Type: RoundTypeSwiss}, nil))
+
+	// c and d both have 3 points, but c met the stronger opponents
+	if standings.Standings[1].PlayerId != "c" || standings.Standings[1].Buchholz != 7 {
+		t.Fatalf("Expected c second with a Buchholz score of 7, got %+v", standings.Standings[1])
+	}
+	if standings.TieBreaks[0].TieBreaker != TieBreakBuchholz {
+		t.Errorf("Expected the tie to be broken by Buchholz, got %s", standings.TieBreaks[0].TieBreaker)
+	}
+}
@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"hash/fnv"
+	"strconv"
+)
+
+// TieBreaker identifies a rule that separates players with equal points
+type TieBreaker string
+
+const (
+	// TieBreakMostWins prefers the player with more first places
+	TieBreakMostWins TieBreaker = "MOST_WINS"
+	// TieBreakBestPlacement prefers the player with the better single best placement
+	TieBreakBestPlacement TieBreaker = "BEST_PLACEMENT"
+	// TieBreakHeadToHead prefers the player who finished ahead of the other tied players more often
+	TieBreakHeadToHead TieBreaker = "HEAD_TO_HEAD"
+	// TieBreakQualifyingTime prefers the player with the faster qualifying time
+	TieBreakQualifyingTime TieBreaker = "QUALIFYING_TIME"
+	// TieBreakBuchholz prefers the player whose opponents scored more points
+	TieBreakBuchholz TieBreaker = "BUCHHOLZ"
+	// TieBreakSonnebornBerger prefers the player who finished ahead of opponents with more points
+	TieBreakSonnebornBerger TieBreaker = "SONNEBORN_BERGER"
+	// TieBreakCoinFlip separates the players by a deterministic coin flip derived from a stored seed
+	TieBreakCoinFlip TieBreaker = "COIN_FLIP"
+)
+
+// DefaultTieBreakers is used when a round does not configure its own chain
+var DefaultTieBreakers = []TieBreaker{
+	TieBreakMostWins,
+	TieBreakBestPlacement,
+	TieBreakHeadToHead,
+	TieBreakQualifyingTime,
+	TieBreakCoinFlip,
+}
+
+// DefaultSwissTieBreakers is used for Swiss rounds that do not configure their own chain
+var DefaultSwissTieBreakers = []TieBreaker{
+	TieBreakBuchholz,
+	TieBreakSonnebornBerger,
+	TieBreakMostWins,
+	TieBreakHeadToHead,
+	TieBreakQualifyingTime,
+	TieBreakCoinFlip,
+}
+
+// TieBreakerChain holds the ordered rules and the data needed to apply them
+type TieBreakerChain struct {
+	Rules           []TieBreaker
+	Seed            int64
+	QualifyingTimes map[string]int
+}
+
+// TieBreakersFor returns the tie-breaker chain of a round. The coin flip is always the last rule,
+// so the resulting standings never contain unresolved ties.
+func TieBreakersFor(round *Round, qualifyingTimes map[string]int) TieBreakerChain {
+	rules := round.TieBreakers
+	if len(rules) == 0 && round.Type == RoundTypeSwiss {
+		rules = DefaultSwissTieBreakers
+	} else if len(rules) == 0 {
+		rules = DefaultTieBreakers
+	}
+
+	chain := TieBreakerChain{
+		Rules:           make([]TieBreaker, 0, len(rules)+1),
+		Seed:            round.TieBreakSeed,
+		QualifyingTimes: qualifyingTimes,
+	}
+	for _, rule := range rules {
+		if rule != TieBreakCoinFlip {
+			chain.Rules = append(chain.Rules, rule)
+		}
+	}
+	chain.Rules = append(chain.Rules, TieBreakCoinFlip)
+
+	return chain
+}
+
+// compare returns a negative number if a ranks ahead of b, a positive number if b ranks ahead of a
+// and zero if the rule cannot separate them. headToHead holds the head-to-head scores of the tied players.
+func (c TieBreakerChain) compare(rule TieBreaker, a *Standing, b *Standing, headToHead map[string]int) int {
+	switch rule {
+	case TieBreakMostWins:
+		return b.Wins - a.Wins
+	case TieBreakBestPlacement:
+		return a.bestPlacement() - b.bestPlacement()
+	case TieBreakHeadToHead:
+		return headToHead[b.PlayerId] - headToHead[a.PlayerId]
+	case TieBreakBuchholz:
+		return b.Buchholz - a.Buchholz
+	case TieBreakSonnebornBerger:
+		return b.SonnebornBerger - a.SonnebornBerger
+	case TieBreakQualifyingTime:
+		return c.qualifyingTime(a.PlayerId) - c.qualifyingTime(b.PlayerId)
+	case TieBreakCoinFlip:
+		return compareUint64(c.coinFlip(a.PlayerId), c.coinFlip(b.PlayerId))
+	default:
+		return 0
+	}
+}
+
+// qualifyingTime returns the qualifying time of a player, ranking players without a valid time last
+func (c TieBreakerChain) qualifyingTime(playerId string) int {
+	time, ok := c.QualifyingTimes[playerId]
+	if !ok || time < 0 {
+		return int(^uint(0) >> 1)
+	}
+	return time
+}
+
+// coinFlip derives a stable pseudo-random value for a player from the chain's seed
+func (c TieBreakerChain) coinFlip(playerId string) uint64 {
+	hash := fnv.New64a()
+	hash.Write([]byte(strconv.FormatInt(c.Seed, 10)))
+	hash.Write([]byte(playerId))
+	return hash.Sum64()
+}
+
+func compareUint64(a uint64, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
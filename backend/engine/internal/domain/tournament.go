@@ -1,5 +1,9 @@
 package domain
 
+import (
+	"time"
+)
+
 // Tournament represents a tournament entity
 type Tournament struct {
 	// Table: tournaments
@@ -13,37 +17,75 @@ type Tournament struct {
 	PlayerCount            int              `json:"playerCount"`
 	Rounds                 []Round          `json:"rounds"`
 	AllowUnderfilledGroups bool             `json:"allowUnderfilledGroups"`
+	SeedingStrategy        SeedingStrategy  `json:"seedingStrategy"`
+	SeedingSeed            int64            `json:"seedingSeed"`
+	PointsScheme           PointsScheme     `json:"pointsScheme"`
+	MapPool                *MapPool         `json:"mapPool,omitempty"`
+	Qualifying             QualifyingConfig `json:"qualifying"`
+	Schedule               ScheduleConfig   `json:"schedule"`
+	PausedBy               string           `json:"pausedBy,omitempty"`
+	PauseReason            string           `json:"pauseReason,omitempty"`
+	PausedAt               *time.Time       `json:"pausedAt,omitempty"`
+	// Events are the recorded events that have not been written to the outbox yet
+	Events []Event `json:"-"`
 }
 
 type Round struct {
 	// Table: rounds
-	Id                     string  `json:"id"`
-	Name                   string  `json:"name"`
-	TournamentId           string  `json:"tournamentId"`
-	MatchCount             int     `json:"matchCount"`
-	PlayerCount            int     `json:"playerCount"`
-	PlayerAdvancementCount int     `json:"playerAdvancementCount"`
-	GroupSize              int     `json:"groupSize"`
-	ConcurrentGroupCount   int     `json:"concurrentGroupCount"`
-	Groups                 []Group `json:"groups"`
+	Id                     string        `json:"id"`
+	Name                   string        `json:"name"`
+	TournamentId           string        `json:"tournamentId"`
+	Position               int           `json:"position"`
+	Type                   RoundType     `json:"type"`
+	MatchCount             int           `json:"matchCount"`
+	PlayerCount            int           `json:"playerCount"`
+	PlayerAdvancementCount int           `json:"playerAdvancementCount"`
+	LuckyLoserCount        int           `json:"luckyLoserCount"`
+	GroupSize              int           `json:"groupSize"`
+	ConcurrentGroupCount   int           `json:"concurrentGroupCount"`
+	PointsScheme           *PointsScheme `json:"pointsScheme,omitempty"`
+	MapPool                *MapPool      `json:"mapPool,omitempty"`
+	TieBreakers            []TieBreaker  `json:"tieBreakers,omitempty"`
+	TieBreakSeed           int64         `json:"tieBreakSeed"`
+	GrandFinalReset        bool          `json:"grandFinalReset"`
+	RoundRobinCycles       int           `json:"roundRobinCycles"`
+	Groups                 []Group       `json:"groups"`
 }
 
 type Group struct {
 	// Table: groups
-	Id      string  `json:"id"`
-	Name    string  `json:"name"`
-	RoundId string  `json:"roundId"`
-	Matches []Match `json:"matches"`
+	Id       string   `json:"id"`
+	Name     string   `json:"name"`
+	RoundId  string   `json:"roundId"`
+	Position int      `json:"position"`
+	Players  []Player `json:"players"`
+	Matches  []Match  `json:"matches"`
 }
 
 type Match struct {
 	// Table: matches
-	Id         string      `json:"id"`
-	GroupId    string      `json:"groupId"`
-	MapName    string      `json:"mapName"`
+	Id       string `json:"id"`
+	GroupId  string `json:"groupId"`
+	Position int    `json:"position"`
+	// Stage is the Swiss round, bracket round or round-robin matchday the match belongs to
+	Stage   int     `json:"stage"`
+	Bracket Bracket `json:"bracket,omitempty"`
+	MapName string  `json:"mapName"`
+	// MapPickerId is the player who picks the map if the map pool lets the players pick
+	MapPickerId string `json:"mapPickerId,omitempty"`
+	// NextMatchPosition and LoserNextMatchPosition link a bracket match to the matches its winner and loser advance to
+	NextMatchPosition      *int `json:"nextMatchPosition,omitempty"`
+	LoserNextMatchPosition *int `json:"loserNextMatchPosition,omitempty"`
+	// Players holds the participants of the match (Table: match_players). It is empty if the whole group plays the match.
+	Players    []Player    `json:"players,omitempty"`
 	Placements []Placement `json:"placements"`
 }
 
+// IsBye reports whether the match seats a single player who advances without playing
+func (m *Match) IsBye() bool {
+	return len(m.Players) == 1
+}
+
 type Placement struct {
 	// Table: placements
 	Id        string `json:"id"`
@@ -52,6 +94,81 @@ type Placement struct {
 	Placement int    `json:"placement"`
 }
 
+// GroupCount returns the number of groups the round is split into
+func (r *Round) GroupCount() int {
+	if r.GroupSize <= 0 {
+		return 0
+	}
+	return r.PlayerCount / r.GroupSize
+}
+
+// RoundRobinMatchCount returns the number of matches in which every player of a group meets every other player
+// once per cycle
+func (r *Round) RoundRobinMatchCount() int {
+	return r.GroupSize * (r.GroupSize - 1) / 2 * max(r.RoundRobinCycles, 1)
+}
+
+// PoolAdvancementCount returns how many players advance from each group by their rank, without lucky losers.
+// Swiss and elimination rounds play in a single pool, which advances PlayerAdvancementCount players per table.
+func (r *Round) PoolAdvancementCount() int {
+	if r.Type.IsSinglePool() {
+		return r.PlayerAdvancementCount * r.GroupCount()
+	}
+	return r.PlayerAdvancementCount
+}
+
+// QualifiedCount returns how many players of the round advance by their rank, without lucky losers.
+// Every group, or every table of a single pool, contributes PlayerAdvancementCount players.
+func (r *Round) QualifiedCount() int {
+	return r.PlayerAdvancementCount * r.GroupCount()
+}
+
+// NonQualifiedCount returns how many players of the round do not advance by their rank
+// and can therefore be drawn as lucky losers
+func (r *Round) NonQualifiedCount() int {
+	return r.PlayerCount - r.QualifiedCount()
+}
+
+// FindRound returns the round with the given id
+func (t *Tournament) FindRound(id string) (*Round, error) {
+	for i := range t.Rounds {
+		if t.Rounds[i].Id == id {
+			return &t.Rounds[i], nil
+		}
+	}
+	return nil, NewNotFoundError("round not found")
+}
+
+// FindGroup returns the group with the given id
+func (r *Round) FindGroup(id string) (*Group, error) {
+	for i := range r.Groups {
+		if r.Groups[i].Id == id {
+			return &r.Groups[i], nil
+		}
+	}
+	return nil, NewNotFoundError("group not found")
+}
+
+// FindMatch returns the match with the given id
+func (g *Group) FindMatch(id string) (*Match, error) {
+	for i := range g.Matches {
+		if g.Matches[i].Id == id {
+			return &g.Matches[i], nil
+		}
+	}
+	return nil, NewNotFoundError("match not found")
+}
+
+// HasPlayer reports whether the player is assigned to the group
+func (g *Group) HasPlayer(playerId string) bool {
+	for _, player := range g.Players {
+		if player.Id == playerId {
+			return true
+		}
+	}
+	return false
+}
+
 type PlayerToGroup struct {
 	// Table: player_to_group
 	PlayerId string  `json:"playerId"`
@@ -79,6 +196,8 @@ const (
 	StatusDraft TournamentStatus = "DRAFT"
 	// StatusActive indicates the tournament is active
 	StatusActive TournamentStatus = "ACTIVE"
+	// StatusPaused indicates the tournament is temporarily interrupted
+	StatusPaused TournamentStatus = "PAUSED"
 	// StatusCompleted indicates the tournament is completed
 	StatusCompleted TournamentStatus = "COMPLETED"
 	// StatusCancelled indicates the tournament is canceled
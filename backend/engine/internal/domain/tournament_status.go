@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// tournamentTransitions lists the statuses a tournament may move to from each status
+var tournamentTransitions = map[TournamentStatus][]TournamentStatus{
+	StatusDraft:  {StatusActive, StatusCancelled},
+	StatusActive: {StatusCompleted, StatusCancelled, StatusPaused},
+	StatusPaused: {StatusActive, StatusCancelled},
+}
+
+// ParseTournamentStatus converts a string into a known tournament status
+func ParseTournamentStatus(status string) (TournamentStatus, error) {
+	switch TournamentStatus(status) {
+	case StatusDraft, StatusActive, StatusPaused, StatusCompleted, StatusCancelled:
+		return TournamentStatus(status), nil
+	default:
+		return "", NewInvalidParameterError("invalid status")
+	}
+}
+
+// StatusChange is the payload of the status.changed event
+type StatusChange struct {
+	From TournamentStatus `json:"from"`
+	To   TournamentStatus `json:"to"`
+}
+
+// CanTransitionTo reports whether a tournament may move from this status to the next status
+func (s TournamentStatus) CanTransitionTo(next TournamentStatus) bool {
+	for _, allowed := range tournamentTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// IsRunning reports whether the tournament has started and not yet ended.
+// The structure of a running tournament must not be edited.
+func (s TournamentStatus) IsRunning() bool {
+	return s == StatusActive || s == StatusPaused
+}
+
+// TransitionTo moves the tournament to the next status if the transition is allowed and its guard conditions hold.
+// Every transition records a status.changed event, which is stored together with the new status.
+func (t *Tournament) TransitionTo(next TournamentStatus) error {
+	if !t.Status.CanTransitionTo(next) {
+		return NewConflictError(fmt.Sprintf("tournament cannot move from %s to %s", t.Status, next))
+	}
+
+	var err error
+	switch next {
+	case StatusActive:
+		if t.Status == StatusDraft {
+			err = t.checkCanActivate()
+		}
+	case StatusCompleted:
+		err = t.checkCanComplete()
+	}
+	if err != nil {
+		return err
+	}
+
+	if t.Status == StatusPaused {
+		t.PausedBy = ""
+		t.PauseReason = ""
+		t.PausedAt = nil
+	}
+
+	t.RecordEvent("status.changed", StatusChange{From: t.Status, To: next})
+	t.Status = next
+	return nil
+}
+
+// Pause interrupts an active tournament and records who paused it and why
+func (t *Tournament) Pause(pausedBy string, reason string, pausedAt time.Time) error {
+	if err := t.TransitionTo(StatusPaused); err != nil {
+		return err
+	}
+
+	t.PausedBy = pausedBy
+	t.PauseReason = reason
+	t.PausedAt = &pausedAt
+	return nil
+}
+
+// checkCanActivate ensures that the first round can be drawn with the players of the main event
+func (t *Tournament) checkCanActivate() error {
+	if len(t.Rounds) == 0 {
+		return NewConflictError("tournament has no rounds")
+	}
+
+	firstRound := t.Rounds[0]
+	players := len(t.MainEventPlayers())
+	if players > firstRound.PlayerCount {
+		return NewConflictError(fmt.Sprintf("first round has room for %d players, got %d", firstRound.PlayerCount, players))
+	}
+
+	if t.AllowUnderfilledGroups {
+		if players < firstRound.GroupCount() {
+			return NewConflictError(fmt.Sprintf("first round requires at least %d players, got %d", firstRound.GroupCount(), players))
+		}
+	} else if players < firstRound.PlayerCount {
+		return NewConflictError(fmt.Sprintf("first round requires %d players, got %d", firstRound.PlayerCount, players))
+	}
+
+	return nil
+}
+
+// checkCanComplete ensures that the last round has been played
+func (t *Tournament) checkCanComplete() error {
+	if len(t.Rounds) == 0 || !t.Rounds[len(t.Rounds)-1].IsComplete() {
+		return NewConflictError("tournament cannot be completed before the last round is finished")
+	}
+	return nil
+}
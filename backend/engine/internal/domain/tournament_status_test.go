@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"testing"
+)
+
+func statusTestTournament(status TournamentStatus, playerCount int, allowUnderfilledGroups bool) *Tournament {
+	tournament := &Tournament{
+		Status:                 status,
+		AllowUnderfilledGroups: allowUnderfilledGroups,
+		Rounds:                 []Round{{Id: "round-1", PlayerCount: 4, GroupSize: 2}},
+	}
+	for i := 0; i < playerCount; i++ {
+		tournament.Players = append(tournament.Players, Player{})
+	}
+	return tournament
+}
+
+func TestTournamentTransitionTo(t *testing.T) {
+	t.Run("allowed transitions", func(t *testing.T) {
+		cases := []struct {
+			from TournamentStatus
+			to   TournamentStatus
+		}{
+			{StatusDraft, StatusActive},
+			{StatusDraft, StatusCancelled},
+			{StatusActive, StatusCancelled},
+		}
+
+		for _, c := range cases {
+			tournament := statusTestTournament(c.from, 4, false)
+			if err := tournament.TransitionTo(c.to); err != nil {
+				t.Errorf("Expected %s -> %s to be allowed, got %v", c.from, c.to, err)
+			}
+			if tournament.Status != c.to {
+				t.Errorf("Expected status %s, got %s", c.to, tournament.Status)
+			}
+			expected := Event{Name: "status.changed", Payload: StatusChange{From: c.from, To: c.to}}
+			if len(tournament.Events) != 1 || tournament.Events[0] != expected {
+				t.Errorf("Expected a status.changed event from %s to %s, got %+v", c.from, c.to, tournament.Events)
+			}
+		}
+	})
+
+	t.Run("rejected transitions", func(t *testing.T) {
+		cases := []struct {
+			from TournamentStatus
+			to   TournamentStatus
+		}{
+			{StatusCompleted, StatusDraft},
+			{StatusCancelled, StatusActive},
+			{StatusActive, StatusDraft},
+			{StatusDraft, StatusCompleted},
+			{StatusActive, StatusActive},
+		}
+
+		for _, c := range cases {
+			tournament := statusTestTournament(c.from, 4, false)
+			err := tournament.TransitionTo(c.to)
+			if !IsConflict(err) {
+				t.Errorf("Expected %s -> %s to be rejected with a conflict, got %v", c.from, c.to, err)
+			}
+			if tournament.Status != c.from {
+				t.Errorf("Expected status to remain %s, got %s", c.from, tournament.Status)
+			}
+			if len(tournament.Events) != 0 {
+				t.Errorf("Expected no event for a rejected transition, got %+v", tournament.Events)
+			}
+		}
+	})
+
+	t.Run("activation requires a full first round", func(t *testing.T) {
+		tournament := statusTestTournament(StatusDraft, 3, false)
+		if err := tournament.TransitionTo(StatusActive); !IsConflict(err) {
+			t.Errorf("Expected a conflict, got %v", err)
+		}
+	})
+
+	t.Run("activation with underfilled groups", func(t *testing.T) {
+		tournament := statusTestTournament(StatusDraft, 3, true)
+		if err := tournament.TransitionTo(StatusActive); err != nil {
+			t.Errorf("Expected activation to be allowed, got %v", err)
+		}
+	})
+
+	t.Run("completion requires a finished last round", func(t *testing.T) {
+		tournament := statusTestTournament(StatusActive, 4, false)
+		if err := tournament.TransitionTo(StatusCompleted); !IsConflict(err) {
+			t.Errorf("Expected a conflict, got %v", err)
+		}
+
+		tournament.Rounds[0].Groups = []Group{{Matches: []Match{{Placements: []Placement{{Placement: 1}}}}}}
+		if err := tournament.TransitionTo(StatusCompleted); err != nil {
+			t.Errorf("Expected completion to be allowed, got %v", err)
+		}
+	})
+}
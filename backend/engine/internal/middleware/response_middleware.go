@@ -65,6 +65,9 @@ func inferStatusCode(err error) int {
 	if domain.IsNotAllowed(err) {
 		return http.StatusMethodNotAllowed
 	}
+	if domain.IsConflict(err) {
+		return http.StatusConflict
+	}
 
 	return http.StatusInternalServerError
 }
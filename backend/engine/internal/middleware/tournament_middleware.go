@@ -6,6 +6,7 @@ import (
 	"engine/internal/ports/input"
 	"github.com/go-chi/chi/v5"
 	"net/http"
+	"strings"
 )
 
 type TournamentKey struct{}
@@ -28,8 +29,8 @@ func TournamentActiveMiddleware() func(http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			tournament := r.Context().Value(TournamentKey{}).(*domain.Tournament)
 
-			if tournament.Status == domain.StatusActive {
-				panic(domain.NewNotAllowedError("Tournament is active."))
+			if tournament.Status.IsRunning() {
+				panic(domain.NewNotAllowedError("Tournament is " + strings.ToLower(string(tournament.Status)) + "."))
 			}
 
 			next.ServeHTTP(w, r)
@@ -0,0 +1,15 @@
+package input
+
+import (
+	"context"
+	"engine/internal/domain"
+)
+
+// MatchServiceInterface defines the interface for match business operations
+type MatchServiceInterface interface {
+	// RecordPlacements stores the finishing order of a match
+	RecordPlacements(ctx context.Context, tournamentId string, roundId string, groupId string, matchId string, placements []domain.Placement) *domain.Match
+
+	// SetMapName records the map a match is played on
+	SetMapName(ctx context.Context, tournamentId string, roundId string, groupId string, matchId string, mapName string) *domain.Match
+}
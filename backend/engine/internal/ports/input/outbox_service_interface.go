@@ -0,0 +1,12 @@
+package input
+
+import (
+	"context"
+	"time"
+)
+
+// OutboxServiceInterface defines the interface for publishing the events stored in the outbox
+type OutboxServiceInterface interface {
+	// DispatchEvents publishes pending outbox events to the broker until the context is cancelled
+	DispatchEvents(ctx context.Context, interval time.Duration)
+}
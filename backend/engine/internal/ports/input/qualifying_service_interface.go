@@ -3,6 +3,7 @@ package input
 import (
 	"context"
 	"engine/internal/domain"
+	"time"
 )
 
 type QualifyingServiceInterface interface {
@@ -10,5 +11,20 @@ type QualifyingServiceInterface interface {
 
 	DeleteQualifyingByTournamentId(ctx context.Context, id string)
 
-	AddPlayerToQualifying(ctx context.Context, tournamentId string, playerId string)
+	// SignUpPlayer registers a new player and adds them to the qualifying until qualifying is closed
+	SignUpPlayer(ctx context.Context, tournamentId string, name string) *domain.Player
+
+	// WithdrawPlayer removes a player and promotes the best ranked waitlisted player into a freed spot
+	WithdrawPlayer(ctx context.Context, tournamentId string, playerId string)
+
+	SubmitQualifyingTime(ctx context.Context, tournamentId string, playerId string, milliseconds int) *domain.QualifyingPlayer
+
+	ListQualifyingAttempts(ctx context.Context, tournamentId string, playerId string) []*domain.QualifyingAttempt
+
+	OpenQualifying(ctx context.Context, tournamentId string) *domain.Tournament
+
+	CloseQualifying(ctx context.Context, tournamentId string, overflow domain.PlayerStatus) *domain.Tournament
+
+	// WatchQualifyingWindows publishes an event whenever a scheduled qualifying window opens or closes
+	WatchQualifyingWindows(ctx context.Context, interval time.Duration)
 }
@@ -0,0 +1,18 @@
+package input
+
+import (
+	"context"
+	"engine/internal/domain"
+)
+
+// ScheduleServiceInterface defines the interface for schedule operations
+type ScheduleServiceInterface interface {
+	// GetSchedule lays out the rounds of a tournament in time slots
+	GetSchedule(ctx context.Context, tournamentId string) *domain.Schedule
+
+	// GetCalendar returns the tournament dates, the qualifying window and the scheduled slots as calendar events
+	GetCalendar(ctx context.Context, tournamentId string, playerId string) []domain.CalendarEvent
+
+	// DelaySlot postpones a slot and all slots after it
+	DelaySlot(ctx context.Context, tournamentId string, delay domain.ScheduleDelay) *domain.Schedule
+}
@@ -0,0 +1,12 @@
+package input
+
+import (
+	"context"
+	"engine/internal/domain"
+)
+
+// StandingsServiceInterface defines the interface for standings calculations
+type StandingsServiceInterface interface {
+	// GetGroupStandings calculates the standings of a group
+	GetGroupStandings(ctx context.Context, tournamentId string, roundId string, groupId string) *domain.GroupStandings
+}
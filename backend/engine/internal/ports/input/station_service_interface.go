@@ -0,0 +1,18 @@
+package input
+
+import (
+	"context"
+	"engine/internal/domain"
+)
+
+// StationServiceInterface defines the interface for station operations
+type StationServiceInterface interface {
+	// ListStations returns all stations of a tournament
+	ListStations(ctx context.Context, tournamentId string) []domain.Station
+
+	// CreateStation adds a station to a tournament
+	CreateStation(ctx context.Context, station *domain.Station) *domain.Station
+
+	// DeleteStation removes a free station from a tournament
+	DeleteStation(ctx context.Context, tournamentId string, stationId string)
+}
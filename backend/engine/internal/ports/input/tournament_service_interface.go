@@ -20,6 +20,12 @@ type TournamentServiceInterface interface {
 	// UpdateTournamentStatus updates the status of a tournament
 	UpdateTournamentStatus(ctx context.Context, id string, status domain.TournamentStatus) *domain.Tournament
 
+	// PauseTournament interrupts an active tournament and records who paused it and why
+	PauseTournament(ctx context.Context, id string, pausedBy string, reason string) *domain.Tournament
+
+	// ResumeTournament continues a paused tournament
+	ResumeTournament(ctx context.Context, id string) *domain.Tournament
+
 	// DeleteTournament removes a tournament
 	DeleteTournament(ctx context.Context, id string)
 }
@@ -0,0 +1,14 @@
+package output
+
+import (
+	"context"
+	"time"
+)
+
+// LeaderLockInterface defines the interface for running background work on a single replica at a time
+type LeaderLockInterface interface {
+	// RunAsLeader runs fn while this replica holds the lock with the given name and blocks until ctx is cancelled.
+	// Replicas that do not hold the lock try to acquire it every retryInterval. The context passed to fn is
+	// cancelled when the lock is lost.
+	RunAsLeader(ctx context.Context, name string, retryInterval time.Duration, fn func(ctx context.Context))
+}
@@ -0,0 +1,21 @@
+package output
+
+import (
+	"context"
+	"engine/internal/domain"
+)
+
+// MatchRepositoryInterface defines the interface for match data access
+type MatchRepositoryInterface interface {
+	// ReplacePlacements atomically replaces all placements of a match
+	ReplacePlacements(ctx context.Context, matchId string, placements []domain.Placement) ([]domain.Placement, error)
+
+	// InsertMatches persists additional matches of a group including their participants
+	InsertMatches(ctx context.Context, groupId string, matches []domain.Match) ([]domain.Match, error)
+
+	// SaveProgress persists the participants, maps and new placements of matches that advanced in a bracket
+	SaveProgress(ctx context.Context, matches []*domain.Match) error
+
+	// UpdateMapName stores the map a match is played on
+	UpdateMapName(ctx context.Context, matchId string, mapName string) error
+}
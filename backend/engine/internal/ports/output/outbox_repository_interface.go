@@ -0,0 +1,20 @@
+package output
+
+import (
+	"context"
+	"engine/internal/domain"
+)
+
+// OutboxRepositoryInterface defines the interface for access to the events waiting in the outbox
+type OutboxRepositoryInterface interface {
+	// InsertEvents writes the recorded events of a tournament to the outbox and clears them.
+	// Within a running transaction the events are committed together with the changes that caused them.
+	InsertEvents(ctx context.Context, tournament *domain.Tournament) error
+
+	// FindPending returns up to limit events that have not been dispatched yet, oldest first.
+	// Within a running transaction the events are claimed until it ends, so concurrent dispatchers skip them.
+	FindPending(ctx context.Context, limit int) ([]domain.OutboxEvent, error)
+
+	// MarkDispatched records that the given events have been published
+	MarkDispatched(ctx context.Context, ids []int64) error
+}
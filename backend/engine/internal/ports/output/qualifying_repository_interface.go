@@ -3,6 +3,7 @@ package output
 import (
 	"context"
 	"engine/internal/domain"
+	"time"
 )
 
 type QualifyingRepositoryInterface interface {
@@ -11,4 +12,17 @@ type QualifyingRepositoryInterface interface {
 	DeleteByTournamentId(ctx context.Context, id string) error
 
 	AddPlayer(ctx context.Context, tournamentId string, playerId string) error
+
+	AddAttempt(ctx context.Context, attempt *domain.QualifyingAttempt) (*domain.QualifyingAttempt, error)
+
+	FindAttempts(ctx context.Context, tournamentId string, playerId string) ([]*domain.QualifyingAttempt, error)
+
+	FindWindowChanges(ctx context.Context, from time.Time, to time.Time) ([]string, error)
+
+	// LockWindowWatermark returns the time up to which qualifying window changes have been announced and locks it
+	// until the running transaction ends. The given time is stored if no watermark exists yet.
+	LockWindowWatermark(ctx context.Context, initial time.Time) (time.Time, error)
+
+	// SaveWindowWatermark stores the time up to which qualifying window changes have been announced
+	SaveWindowWatermark(ctx context.Context, watchedUntil time.Time) error
 }
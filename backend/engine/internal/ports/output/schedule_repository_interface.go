@@ -0,0 +1,15 @@
+package output
+
+import (
+	"context"
+	"engine/internal/domain"
+)
+
+// ScheduleRepositoryInterface defines the interface for schedule data access
+type ScheduleRepositoryInterface interface {
+	// FindDelays returns all delays of a tournament in the order they were added
+	FindDelays(ctx context.Context, tournamentId string) ([]domain.ScheduleDelay, error)
+
+	// AddDelay stores a delay of a slot
+	AddDelay(ctx context.Context, tournamentId string, delay *domain.ScheduleDelay) (*domain.ScheduleDelay, error)
+}
@@ -0,0 +1,21 @@
+package output
+
+import (
+	"context"
+	"engine/internal/domain"
+)
+
+// StationRepositoryInterface defines the interface for station data access
+type StationRepositoryInterface interface {
+	// FindByTournamentId returns all stations of a tournament ordered by number
+	FindByTournamentId(ctx context.Context, tournamentId string) ([]domain.Station, error)
+
+	// Insert stores a new station. A station without a number receives the next free number.
+	Insert(ctx context.Context, station *domain.Station) (*domain.Station, error)
+
+	// Delete removes a station of a tournament
+	Delete(ctx context.Context, tournamentId string, stationId string) error
+
+	// SaveAssignments stores the groups currently playing on the given stations
+	SaveAssignments(ctx context.Context, stations []domain.Station) error
+}
@@ -10,6 +10,9 @@ type TournamentRepositoryInterface interface {
 	// FindByID retrieves a tournament by its Id
 	FindByID(ctx context.Context, id string) (*domain.Tournament, error)
 
+	// FindByIDForUpdate retrieves a tournament by its Id and locks it until the running transaction ends
+	FindByIDForUpdate(ctx context.Context, id string) (*domain.Tournament, error)
+
 	// FindAll retrieves all tournaments
 	FindAll(ctx context.Context) ([]*domain.IndexTournament, error)
 
@@ -21,4 +24,13 @@ type TournamentRepositoryInterface interface {
 
 	// Update updates a tournament
 	Update(ctx context.Context, tournament *domain.Tournament) (*domain.Tournament, error)
+
+	// StartRound updates a tournament and persists the drawn groups of the given round in a single transaction
+	StartRound(ctx context.Context, tournament *domain.Tournament, round *domain.Round) (*domain.Tournament, error)
+
+	// UpdateQualifyingWindow persists the time when qualifying was opened manually
+	UpdateQualifyingWindow(ctx context.Context, tournament *domain.Tournament) (*domain.Tournament, error)
+
+	// UpdateQualifyingResult persists the qualifying closing time and the status of every player in a single transaction
+	UpdateQualifyingResult(ctx context.Context, tournament *domain.Tournament) (*domain.Tournament, error)
 }
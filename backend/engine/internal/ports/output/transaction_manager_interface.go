@@ -0,0 +1,10 @@
+package output
+
+import "context"
+
+// TransactionManagerInterface defines the interface for running several repository calls as one unit of work
+type TransactionManagerInterface interface {
+	// WithinTransaction runs fn in a transaction that is committed when fn succeeds and rolled back when it
+	// fails or panics. Repository calls made with the context passed to fn join the transaction.
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -3,11 +3,16 @@ type EventCallback = (payload: any) => void;
 interface SubscriptionMessage {
 	action: 'subscribe' | 'unsubscribe';
 	topic: string;
+	epoch?: string;
+	fromSeq?: number;
 }
 
 interface EventMessage {
+	epoch: string;
+	seq: number;
 	topic: string;
 	payload: any;
+	timestamp: string;
 }
 
 export class WebSocketService {
@@ -18,6 +23,9 @@ export class WebSocketService {
 	private maxReconnectAttempts = 5;
 	private reconnectDelay = 1000;
 	private isIntentionallyClosed = false;
+	// Epoch and sequence number of the last received message, used to replay messages missed while reconnecting
+	private lastEpoch: string | null = null;
+	private lastSeq: number | null = null;
 
 	constructor(url: string) {
 		this.url = url;
@@ -37,9 +45,9 @@ export class WebSocketService {
 				console.log('WebSocket connected');
 				this.reconnectAttempts = 0;
 
-				// Resubscribe to all topics
+				// Resubscribe to all topics and replay the messages missed while disconnected
 				this.subscribers.forEach((_, topic) => {
-					this.sendSubscription('subscribe', topic);
+					this.sendSubscription('subscribe', topic, this.lastEpoch ?? undefined, this.lastSeq ?? undefined);
 				});
 
 				resolve();
@@ -89,6 +97,8 @@ export class WebSocketService {
 	disconnect() {
 		this.isIntentionallyClosed = true;
 		this.subscribers.clear();
+		this.lastEpoch = null;
+		this.lastSeq = null;
 
 		if (this.ws) {
 			this.ws.close();
@@ -135,8 +145,13 @@ export class WebSocketService {
 		}
 	}
 
-	private sendSubscription(action: 'subscribe' | 'unsubscribe', topic: string) {
-		const message: SubscriptionMessage = { action, topic };
+	private sendSubscription(
+		action: 'subscribe' | 'unsubscribe',
+		topic: string,
+		epoch?: string,
+		fromSeq?: number
+	) {
+		const message: SubscriptionMessage = { action, topic, epoch, fromSeq };
 		this.send(message);
 	}
 
@@ -149,8 +164,17 @@ export class WebSocketService {
 	}
 
 	private handleMessage(message: EventMessage) {
-		const callbacks = this.subscribers.get(message.topic);
-		if (callbacks) {
+		// The sequence starts over when the server restarts, which is announced by a new epoch
+		if (message.epoch !== this.lastEpoch) {
+			this.lastEpoch = message.epoch;
+			this.lastSeq = message.seq;
+		} else {
+			this.lastSeq = Math.max(this.lastSeq ?? 0, message.seq);
+		}
+
+		this.subscribers.forEach((callbacks, pattern) => {
+			if (!matchTopic(pattern, message.topic)) return;
+
 			callbacks.forEach((callback) => {
 				try {
 					callback(message.payload);
@@ -158,10 +182,30 @@ export class WebSocketService {
 					console.error(`Error in callback for topic ${message.topic}:`, err);
 				}
 			});
-		}
+		});
 	}
 }
 
+// Matches a topic against a subscription pattern: "*" matches exactly one segment, "#" zero or more segments
+export function matchTopic(pattern: string, topic: string): boolean {
+	const match = (patternSegments: string[], topicSegments: string[]): boolean => {
+		if (patternSegments.length === 0) return topicSegments.length === 0;
+
+		const [segment, ...rest] = patternSegments;
+		if (segment === '#') {
+			for (let skip = 0; skip <= topicSegments.length; skip++) {
+				if (match(rest, topicSegments.slice(skip))) return true;
+			}
+			return false;
+		}
+		if (topicSegments.length === 0) return false;
+		if (segment !== '*' && segment !== topicSegments[0]) return false;
+		return match(rest, topicSegments.slice(1));
+	};
+
+	return pattern === topic || match(pattern.split('.'), topic.split('.'));
+}
+
 // Singleton instance for the application
 let wsInstance: WebSocketService | null = null;
 